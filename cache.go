@@ -0,0 +1,192 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// stageVersions lets us bust the content-addressed cache when a stage's
+// pipeline.py implementation changes in a way that would change its output
+// for the same input, without having to touch every cached entry on disk.
+var stageVersions = map[stage]int{
+	stageImport:  1,
+	stageConvert: 1,
+	stageClean:   1,
+	stageCurate:  1,
+}
+
+// stageCacheName returns the job-history and cache-key name for a stage,
+// matching the keys already used in m.results.
+func stageCacheName(s stage) string {
+	switch s {
+	case stageImport:
+		return "import"
+	case stageConvert:
+		return "convert"
+	case stageClean:
+		return "clean"
+	case stageCurate:
+		return "curate"
+	}
+	return ""
+}
+
+// stageOutputName returns the intermediate file a stage writes in workDir.
+func stageOutputName(s stage) string {
+	switch s {
+	case stageImport:
+		return "emails_raw.json"
+	case stageConvert:
+		return "emails.jsonl"
+	case stageClean:
+		return "cleaned_emails.json"
+	case stageCurate:
+		return "style_shortlist.csv"
+	}
+	return ""
+}
+
+// stageInputPath resolves the file a stage reads, mirroring the same
+// fallback runPipelineStage already uses for stageConvert.
+func stageInputPath(workDir, inputFile string, s stage) string {
+	switch s {
+	case stageImport:
+		return inputFile
+	case stageConvert:
+		convertInput := filepath.Join(workDir, "emails_raw.json")
+		if _, err := os.Stat(convertInput); os.IsNotExist(err) {
+			return inputFile
+		}
+		return convertInput
+	case stageClean:
+		return filepath.Join(workDir, "emails.jsonl")
+	case stageCurate:
+		return filepath.Join(workDir, "cleaned_emails.json")
+	}
+	return inputFile
+}
+
+// sha256File hashes a file's contents, used both for cache keys and for the
+// resume-time integrity check against a Job's recorded ShardHashes.
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// configFingerprint hashes the knobs that can change a stage's output for
+// the same input file, so the cache doesn't serve stale results after the
+// sender filter or worker count changes.
+func configFingerprint(sender string, workers int) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("sender=%s|workers=%d", sender, workers)))
+	return hex.EncodeToString(sum[:])
+}
+
+// cacheKey derives the content-addressed cache key from
+// (input_file_hash, stage_name, stage_version, config_hash), so re-running
+// the same mbox reuses completed shards even across different workDirs.
+func cacheKey(inputHash string, s stage, cfgFingerprint string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%s", inputHash, stageCacheName(s), stageVersions[s], cfgFingerprint)))
+	return hex.EncodeToString(sum[:])
+}
+
+// shardCacheDir is the on-disk home for one cache key's saved output.
+func shardCacheDir(key string) string {
+	return filepath.Join(getCacheDir(), "shards", key)
+}
+
+// tryLoadCachedStage looks up a previously cached run of stage s for the
+// current input file/sender/workers, copying its output into workDir and
+// returning its stats on a hit. The returned key is recorded in the Job so
+// a later resume can verify the on-disk file hasn't drifted.
+func tryLoadCachedStage(workDir, inputFile, sender string, workers int, s stage) (map[string]int, string, bool) {
+	inputPath := stageInputPath(workDir, inputFile, s)
+	inputHash, err := sha256File(inputPath)
+	if err != nil {
+		return nil, "", false
+	}
+
+	key := cacheKey(inputHash, s, configFingerprint(sender, workers))
+	dir := shardCacheDir(key)
+	outPath := filepath.Join(dir, stageOutputName(s))
+	if _, err := os.Stat(outPath); err != nil {
+		return nil, "", false
+	}
+
+	statsData, err := os.ReadFile(filepath.Join(dir, "stats.json"))
+	if err != nil {
+		return nil, "", false
+	}
+	var stats map[string]int
+	if err := json.Unmarshal(statsData, &stats); err != nil {
+		return nil, "", false
+	}
+
+	if err := copyFile(outPath, filepath.Join(workDir, stageOutputName(s))); err != nil {
+		return nil, "", false
+	}
+	return stats, key, true
+}
+
+// saveCachedStage persists a completed stage's output and stats under its
+// content-addressed key so a future run (even from a different workDir)
+// can skip re-invoking pipeline.py for the same input.
+func saveCachedStage(workDir, inputFile, sender string, workers int, s stage, stats map[string]int) (string, error) {
+	inputPath := stageInputPath(workDir, inputFile, s)
+	inputHash, err := sha256File(inputPath)
+	if err != nil {
+		return "", err
+	}
+
+	key := cacheKey(inputHash, s, configFingerprint(sender, workers))
+	dir := shardCacheDir(key)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	if err := copyFile(filepath.Join(workDir, stageOutputName(s)), filepath.Join(dir, stageOutputName(s))); err != nil {
+		return "", err
+	}
+
+	statsData, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "stats.json"), statsData, 0644); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// recordShardHash saves the cache key used for a completed stage onto its
+// Job entry, so getIncompleteJob can confirm the on-disk artifact still
+// matches what was cached before offering to resume from it.
+func recordShardHash(workDir, stageName, hash string) {
+	jobs := loadJobs()
+	for i := range jobs {
+		if jobs[i].WorkDir != workDir {
+			continue
+		}
+		if jobs[i].ShardHashes == nil {
+			jobs[i].ShardHashes = map[string]string{}
+		}
+		jobs[i].ShardHashes[stageName] = hash
+	}
+	data, err := json.MarshalIndent(jobs, "", "  ")
+	if err != nil {
+		return
+	}
+	os.WriteFile(getJobsFile(), data, 0644)
+}
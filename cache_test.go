@@ -0,0 +1,56 @@
+package main
+
+import "testing"
+
+func TestCacheKeyDeterministic(t *testing.T) {
+	a := cacheKey("abc123", stageClean, "cfg1")
+	b := cacheKey("abc123", stageClean, "cfg1")
+	if a != b {
+		t.Fatalf("cacheKey not deterministic: %q != %q", a, b)
+	}
+}
+
+func TestCacheKeyVariesWithInputs(t *testing.T) {
+	base := cacheKey("abc123", stageClean, "cfg1")
+
+	tests := []struct {
+		name string
+		key  string
+	}{
+		{"different input hash", cacheKey("def456", stageClean, "cfg1")},
+		{"different stage", cacheKey("abc123", stageCurate, "cfg1")},
+		{"different config fingerprint", cacheKey("abc123", stageClean, "cfg2")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.key == base {
+				t.Fatalf("expected cacheKey to differ from base, both were %q", base)
+			}
+		})
+	}
+}
+
+func TestCacheKeyBustsOnStageVersionBump(t *testing.T) {
+	before := cacheKey("abc123", stageClean, "cfg1")
+
+	orig := stageVersions[stageClean]
+	stageVersions[stageClean] = orig + 1
+	defer func() { stageVersions[stageClean] = orig }()
+
+	after := cacheKey("abc123", stageClean, "cfg1")
+	if before == after {
+		t.Fatal("cacheKey did not change after bumping stageVersions")
+	}
+}
+
+func TestConfigFingerprintVariesWithSenderAndWorkers(t *testing.T) {
+	base := configFingerprint("alice@example.com", 4)
+
+	if got := configFingerprint("bob@example.com", 4); got == base {
+		t.Fatal("expected fingerprint to change with sender")
+	}
+	if got := configFingerprint("alice@example.com", 8); got == base {
+		t.Fatal("expected fingerprint to change with workers")
+	}
+}
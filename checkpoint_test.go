@@ -0,0 +1,73 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckpointMatches(t *testing.T) {
+	workDir := t.TempDir()
+	inputFile := filepath.Join(workDir, "export.mbox")
+	if err := os.WriteFile(inputFile, []byte("original contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if checkpointMatches(workDir, inputFile) {
+		t.Fatal("expected no match before a checkpoint is written")
+	}
+
+	writeCheckpoint(workDir, inputFile, "", stageClean, map[string]int{"total": 1}, "completed")
+
+	if !checkpointMatches(workDir, inputFile) {
+		t.Fatal("expected match right after writing a checkpoint for this input")
+	}
+
+	if err := os.WriteFile(inputFile, []byte("changed contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if checkpointMatches(workDir, inputFile) {
+		t.Fatal("expected no match once the input file's contents changed")
+	}
+}
+
+func TestCheckpointMatchesMissingInputFile(t *testing.T) {
+	workDir := t.TempDir()
+	inputFile := filepath.Join(workDir, "export.mbox")
+	if err := os.WriteFile(inputFile, []byte("contents"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	writeCheckpoint(workDir, inputFile, "", stageImport, map[string]int{}, "completed")
+	os.Remove(inputFile)
+
+	if checkpointMatches(workDir, inputFile) {
+		t.Fatal("expected no match when the input file no longer exists")
+	}
+}
+
+func TestStageFromName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   stage
+		wantOK bool
+	}{
+		{"import", stageImport, true},
+		{"Convert", stageConvert, true},
+		{"  clean  ", stageClean, true},
+		{"curate", stageCurate, true},
+		{"bogus", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := stageFromName(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("stageFromName(%q) ok = %v, want %v", tt.name, ok, tt.wantOK)
+			}
+			if ok && got != tt.want {
+				t.Fatalf("stageFromName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
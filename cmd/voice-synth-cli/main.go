@@ -0,0 +1,96 @@
+// Command voice-synth-cli drives the same import/convert/clean/curate
+// pipeline as the TUI, non-interactively, for scripting, CI, or embedding
+// in other tools.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/s-b-e-n-s-o-n/voice-synth/internal/pipeline"
+)
+
+// stdoutProgress prints each stage's output and completion stats directly.
+type stdoutProgress struct{}
+
+func (stdoutProgress) Line(stage, line string) {
+	fmt.Printf("[%s] %s\n", stage, line)
+}
+
+func (stdoutProgress) StageDone(stage string, stats map[string]int) {
+	fmt.Printf("[%s] done: %v\n", stage, stats)
+}
+
+func getCacheDir() string {
+	if xdg := os.Getenv("XDG_CACHE_HOME"); xdg != "" {
+		return filepath.Join(xdg, "voice-synth")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".cache", "voice-synth")
+}
+
+func getVenvPython() string {
+	venv := filepath.Join(getCacheDir(), "venv")
+	if runtime.GOOS == "windows" {
+		return filepath.Join(venv, "Scripts", "python.exe")
+	}
+	return filepath.Join(venv, "bin", "python3")
+}
+
+func main() {
+	sender := flag.String("sender", "", "owner email address to keep in Clean/Curate")
+	workDir := flag.String("workdir", "", "working directory for intermediate files (default: current directory)")
+	scriptDir := flag.String("script-dir", "", "directory containing pipeline.py (default: alongside this binary)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: voice-synth-cli [flags] <mbox-or-takeout-path>")
+		os.Exit(1)
+	}
+	input := flag.Arg(0)
+
+	wd := *workDir
+	if wd == "" {
+		var err error
+		wd, err = os.Getwd()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "voice-synth-cli:", err)
+			os.Exit(1)
+		}
+	}
+
+	sd := *scriptDir
+	if sd == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "voice-synth-cli:", err)
+			os.Exit(1)
+		}
+		sd = filepath.Dir(exe)
+	}
+
+	runner := &pipeline.Runner{
+		Python:    getVenvPython(),
+		ScriptDir: sd,
+		WorkDir:   wd,
+		Progress:  stdoutProgress{},
+	}
+	p := &pipeline.Pipeline{
+		Runner: runner,
+		Stages: []pipeline.Stage{
+			pipeline.ImportStage{},
+			pipeline.ConvertStage{},
+			pipeline.CleanStage{},
+			pipeline.CurateStage{},
+		},
+	}
+
+	if _, err := p.Run(*sender, input); err != nil {
+		fmt.Fprintln(os.Stderr, "voice-synth-cli:", err)
+		os.Exit(1)
+	}
+	fmt.Println("done:", filepath.Join(wd, "style_shortlist.csv"))
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// Event is one line of the NDJSON progress protocol a pipeline stage writes
+// to stdout in place of free-form log text. Only the fields relevant to
+// Type are populated; the rest are zero values.
+type Event struct {
+	Type   string `json:"type"`
+	Stage  string `json:"stage,omitempty"`
+	Done   int    `json:"done,omitempty"`
+	Total  int    `json:"total,omitempty"`
+	Phase  string `json:"phase,omitempty"`
+	Key    string `json:"key,omitempty"`
+	Value  int    `json:"value,omitempty"`
+	Level  string `json:"level,omitempty"`
+	Msg    string `json:"msg,omitempty"`
+	Entity string `json:"entity,omitempty"`
+}
+
+// Event types understood by the protocol. "stats" is accepted as a synonym
+// for "stat" since pipeline.py stages have shipped with either spelling.
+const (
+	eventTypeProgress = "progress"
+	eventTypeStat     = "stat"
+	eventTypeStats    = "stats"
+	eventTypeLog      = "log"
+	eventTypePIIHit   = "pii_hit"
+)
+
+// Typed messages streamEvents turns each Event into.
+type (
+	progressEventMsg struct {
+		stage string
+		done  int
+		total int
+		phase string
+	}
+	statEventMsg struct {
+		key   string
+		value int
+	}
+	logEventMsg struct {
+		level string
+		msg   string
+	}
+	piiHitEventMsg struct{ entity string }
+)
+
+// streamEvents reads newline-delimited JSON events from r and forwards each
+// as a typed tea.Msg via program.Send as soon as it arrives, so the TUI can
+// render live progress instead of waiting for the stage to finish. Lines
+// that aren't valid Events fall back to a plain logUpdateMsg, so the
+// protocol is opt-in on the Python side: stages that haven't been ported
+// yet just look like ordinary log output. Plain lines and log events (the
+// full text, not the truncated display version) are also appended to the
+// stage's log file under workDir/logs so screenJobHistory has a durable
+// transcript; progress, stat and pii_hit events are not logged.
+func streamEvents(workDir string, stage stage, r io.Reader) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		ev, ok := parseEvent(line)
+		if !ok {
+			appendStageLog(workDir, stage, line)
+			displayLine := line
+			if len(displayLine) > 50 {
+				displayLine = displayLine[:47] + "..."
+			}
+			if program != nil {
+				program.Send(logUpdateMsg{line: displayLine})
+			}
+			continue
+		}
+
+		switch ev.Type {
+		case eventTypeProgress:
+			if program != nil {
+				program.Send(progressEventMsg{stage: ev.Stage, done: ev.Done, total: ev.Total, phase: ev.Phase})
+			}
+		case eventTypeStat, eventTypeStats:
+			if program != nil {
+				program.Send(statEventMsg{key: ev.Key, value: ev.Value})
+			}
+		case eventTypeLog:
+			appendStageLog(workDir, stage, ev.Msg)
+			if program != nil {
+				program.Send(logEventMsg{level: ev.Level, msg: ev.Msg})
+			}
+		case eventTypePIIHit:
+			if program != nil {
+				program.Send(piiHitEventMsg{entity: ev.Entity})
+			}
+		}
+	}
+}
+
+// parseEvent attempts to decode line as a typed Event, reporting ok=false
+// for anything that isn't one - including the plain JSON stats object
+// stages print just before exiting, which carries no "type" field. Shared
+// by streamEvents and the sharded worker-pool path in
+// runPipelineStageConcurrent so both stdout shapes are understood the same
+// way.
+func parseEvent(line string) (Event, bool) {
+	var ev Event
+	if err := json.Unmarshal([]byte(line), &ev); err != nil || ev.Type == "" {
+		return Event{}, false
+	}
+	return ev, true
+}
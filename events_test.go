@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		wantOK bool
+		wantEv Event
+	}{
+		{
+			name:   "progress event",
+			line:   `{"type":"progress","done":3,"total":10,"phase":"scanning"}`,
+			wantOK: true,
+			wantEv: Event{Type: "progress", Done: 3, Total: 10, Phase: "scanning"},
+		},
+		{
+			name:   "stat event",
+			line:   `{"type":"stat","key":"kept","value":7}`,
+			wantOK: true,
+			wantEv: Event{Type: "stat", Key: "kept", Value: 7},
+		},
+		{
+			name:   "stats synonym",
+			line:   `{"type":"stats","key":"total","value":42}`,
+			wantOK: true,
+			wantEv: Event{Type: "stats", Key: "total", Value: 42},
+		},
+		{
+			name:   "pii_hit event",
+			line:   `{"type":"pii_hit","entity":"EMAIL_ADDRESS"}`,
+			wantOK: true,
+			wantEv: Event{Type: "pii_hit", Entity: "EMAIL_ADDRESS"},
+		},
+		{
+			name:   "plain json stats line has no type field",
+			line:   `{"total":100,"kept":80}`,
+			wantOK: false,
+		},
+		{
+			name:   "plain log line",
+			line:   "starting clean stage",
+			wantOK: false,
+		},
+		{
+			name:   "malformed json",
+			line:   `{"type":`,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev, ok := parseEvent(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("parseEvent(%q) ok = %v, want %v", tt.line, ok, tt.wantOK)
+			}
+			if ok && ev != tt.wantEv {
+				t.Fatalf("parseEvent(%q) = %+v, want %+v", tt.line, ev, tt.wantEv)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sahilm/fuzzy"
+)
+
+// excludedDirs are skipped outright during indexing so scanning a home
+// directory with hundreds of thousands of files stays fast, mirroring a
+// .gitignore's usual suspects.
+var excludedDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	".cache":       true,
+	"Library":      true,
+	".Trash":       true,
+	"venv":         true,
+	".venv":        true,
+	"__pycache__":  true,
+	".cargo":       true,
+	".rustup":      true,
+}
+
+// maxIndexDepth bounds how far the scan descends into each root, since
+// Takeout exports and mboxes never live more than a couple levels deep.
+const maxIndexDepth = 4
+
+// fileEntry is one indexed candidate: an .mbox file, a .zip export, or a
+// directory that looks like an unzipped Takeout export.
+type fileEntry struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func (e fileEntry) displayName() string {
+	return filepath.Base(e.path)
+}
+
+// filePickerMode toggles between the fuzzy list and the legacy
+// drag-and-drop paste box.
+type filePickerMode int
+
+const (
+	filePickerModeList filePickerMode = iota
+	filePickerModePaste
+)
+
+// filePickerModel is a Bubble Tea sub-model embedded in the main model for
+// screenFilePicker. It owns its own index, query input, and cursor, and
+// only ever reports a final selection back to the parent via
+// filePickerSelectedMsg.
+type filePickerModel struct {
+	mode     filePickerMode
+	entries  []fileEntry
+	filtered []fileEntry
+	cursor   int
+	query    textinput.Model
+	paste    textinput.Model
+	roots    []string
+}
+
+// filePickerSelectedMsg is sent when the user confirms a file/folder.
+type filePickerSelectedMsg struct{ path string }
+
+func newFilePickerModel(extraRoots ...string) filePickerModel {
+	home, _ := os.UserHomeDir()
+	roots := []string{
+		filepath.Join(home, "Downloads"),
+		filepath.Join(home, "Desktop"),
+	}
+	roots = append(roots, extraRoots...)
+
+	query := textinput.New()
+	query.Placeholder = "Type to filter..."
+	query.Width = 40
+	query.Focus()
+
+	paste := textinput.New()
+	paste.Placeholder = "Drag file here or type path..."
+	paste.Width = 40
+
+	m := filePickerModel{
+		mode:  filePickerModeList,
+		query: query,
+		paste: paste,
+		roots: roots,
+	}
+	m.entries = scanForCandidates(roots)
+	m.filtered = m.entries
+	return m
+}
+
+// scanForCandidates walks each root up to maxIndexDepth looking for .mbox
+// files, .zip archives, and directories named like a Takeout export
+// ("Takeout" or containing a "Mail" subdirectory), skipping excludedDirs.
+func scanForCandidates(roots []string) []fileEntry {
+	var entries []fileEntry
+	seen := map[string]bool{}
+
+	for _, root := range roots {
+		walkDepth(root, 0, &entries, seen)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].modTime.After(entries[j].modTime)
+	})
+	return entries
+}
+
+func walkDepth(dir string, depth int, entries *[]fileEntry, seen map[string]bool) {
+	if depth > maxIndexDepth {
+		return
+	}
+	items, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, item := range items {
+		name := item.Name()
+		if strings.HasPrefix(name, ".") || excludedDirs[name] {
+			continue
+		}
+		full := filepath.Join(dir, name)
+
+		if item.IsDir() {
+			if looksLikeTakeout(name, full) {
+				addEntry(full, entries, seen)
+				continue // don't descend into a matched Takeout export
+			}
+			walkDepth(full, depth+1, entries, seen)
+			continue
+		}
+
+		lower := strings.ToLower(name)
+		if strings.HasSuffix(lower, ".mbox") || strings.HasSuffix(lower, ".zip") {
+			addEntry(full, entries, seen)
+		}
+	}
+}
+
+func looksLikeTakeout(name, path string) bool {
+	if strings.Contains(strings.ToLower(name), "takeout") {
+		return true
+	}
+	if _, err := os.Stat(filepath.Join(path, "Mail")); err == nil {
+		return true
+	}
+	return false
+}
+
+func addEntry(path string, entries *[]fileEntry, seen map[string]bool) {
+	if seen[path] {
+		return
+	}
+	seen[path] = true
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	*entries = append(*entries, fileEntry{path: path, size: info.Size(), modTime: info.ModTime()})
+}
+
+func (m filePickerModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m filePickerModel) Update(msg tea.Msg) (filePickerModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "tab":
+		if m.mode == filePickerModeList {
+			m.mode = filePickerModePaste
+			m.paste.Focus()
+			m.query.Blur()
+		} else {
+			m.mode = filePickerModeList
+			m.query.Focus()
+			m.paste.Blur()
+		}
+		return m, nil
+	}
+
+	if m.mode == filePickerModePaste {
+		if keyMsg.String() == "enter" {
+			path := m.selectedPath()
+			if path == "" {
+				return m, nil
+			}
+			return m, func() tea.Msg {
+				return filePickerSelectedMsg{path: path}
+			}
+		}
+		var cmd tea.Cmd
+		m.paste, cmd = m.paste.Update(keyMsg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "up", "ctrl+k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+	case "down", "ctrl+j":
+		if m.cursor < len(m.filtered)-1 {
+			m.cursor++
+		}
+		return m, nil
+	case "enter":
+		if len(m.filtered) == 0 {
+			return m, nil
+		}
+		return m, func() tea.Msg {
+			return filePickerSelectedMsg{path: m.filtered[m.cursor].path}
+		}
+	}
+
+	var cmd tea.Cmd
+	m.query, cmd = m.query.Update(keyMsg)
+	m.filtered = m.filterEntries()
+	if m.cursor >= len(m.filtered) {
+		m.cursor = 0
+	}
+	return m, cmd
+}
+
+func (m filePickerModel) filterEntries() []fileEntry {
+	query := strings.TrimSpace(m.query.Value())
+	if query == "" {
+		return m.entries
+	}
+
+	names := make([]string, len(m.entries))
+	for i, e := range m.entries {
+		names[i] = e.path
+	}
+
+	matches := fuzzy.Find(query, names)
+	filtered := make([]fileEntry, len(matches))
+	for i, match := range matches {
+		filtered[i] = m.entries[match.Index]
+	}
+	return filtered
+}
+
+// selectedPath returns the paste box's path when in paste mode, for the
+// legacy drag-and-drop flow the parent model falls back to.
+func (m filePickerModel) selectedPath() string {
+	return cleanPath(m.paste.Value())
+}
+
+func (m filePickerModel) View() string {
+	if m.mode == filePickerModePaste {
+		content := m.paste.View() + "\n\n"
+		content += dimStyle.Render("Drag from Finder into this window, then press Enter") + "\n"
+		content += dimStyle.Render("tab switch to list • enter continue • esc back")
+		return content
+	}
+
+	content := m.query.View() + "\n\n"
+
+	if len(m.filtered) == 0 {
+		content += dimStyle.Render("No .mbox/.zip files or Takeout folders found") + "\n"
+	} else {
+		visible := m.filtered
+		const maxRows = 8
+		offset := 0
+		if len(visible) > maxRows {
+			// Keep the cursor inside the visible window instead of letting
+			// it scroll off past row maxRows, so the highlighted entry (and
+			// what enter would select) is always on screen.
+			offset = m.cursor - maxRows + 1
+			if offset < 0 {
+				offset = 0
+			}
+			if maxOffset := len(visible) - maxRows; offset > maxOffset {
+				offset = maxOffset
+			}
+			visible = visible[offset : offset+maxRows]
+		}
+		for i, entry := range visible {
+			idx := offset + i
+			cursor := "  "
+			style := normalStyle
+			if idx == m.cursor {
+				cursor = "▸ "
+				style = selectedStyle
+			}
+			line := cursor + style.Render(entry.displayName())
+			line += "  " + dimStyle.Render(formatSize(entry.size)+" · "+entry.modTime.Format("Jan 2"))
+			content += line + "\n"
+		}
+	}
+
+	content += "\n" + dimStyle.Render("↑/↓ select • enter continue • tab paste path • esc back")
+	return content
+}
+
+func formatSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
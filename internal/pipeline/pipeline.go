@@ -0,0 +1,124 @@
+// Package pipeline defines the voice-synth import/convert/clean/curate
+// stages - what pipeline.py subcommand and flags each one runs, how to read
+// its --json-stats line, and what to do once it completes - independent of
+// any UI. Runner executes them for non-interactive callers like
+// cmd/voice-synth-cli. The TUI's interactive runner (see the root package's
+// events.go and runPipelineStage) shares these same Stage definitions for
+// args/stats/OnComplete, but drives the subprocess itself rather than going
+// through Runner: it additionally needs context cancellation with a graceful
+// kill, the NDJSON live-progress protocol, sharded/worker-pool execution, and
+// checkpointing, none of which Runner or Progress model.
+package pipeline
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+)
+
+// Stage is one step of the pipeline.
+type Stage interface {
+	// Name identifies the stage for logging and for the Results map (e.g.
+	// "import", "convert", "clean", "curate").
+	Name() string
+	// Args returns the pipeline.py subcommand and flags for this stage,
+	// given the working directory, sender filter, and resolved input path.
+	// A Pipeline passes input only to its first stage; later stages get an
+	// empty input and must resolve their own conventional intermediate file.
+	Args(workDir, sender, input string) []string
+	// ParseStats extracts the stage's --json-stats line from its output.
+	ParseStats(output []byte) (map[string]int, error)
+	// OnComplete runs once the subprocess exits 0 (e.g. copying the
+	// shortlist CSV out of workDir).
+	OnComplete(workDir string) error
+}
+
+// Progress is how a Runner reports output and stage completions back to its
+// caller; cmd/voice-synth-cli implements it by printing them. The TUI does
+// not implement Progress, since it does not run stages through Runner.
+type Progress interface {
+	Line(stage, line string)
+	StageDone(stage string, stats map[string]int)
+}
+
+// Results collects every stage's stats, keyed by Stage.Name().
+type Results struct {
+	Stats map[string]map[string]int
+}
+
+// Runner owns the venv/script discovery and workDir a Pipeline executes in.
+type Runner struct {
+	Python    string // path to the venv's python3
+	ScriptDir string // directory containing pipeline.py
+	WorkDir   string
+	Progress  Progress // optional
+}
+
+func (r *Runner) scriptPath() string {
+	return filepath.Join(r.ScriptDir, "pipeline.py")
+}
+
+// Run executes a single stage to completion, streaming its stdout to
+// Progress.Line as it arrives and reporting Progress.StageDone once parsed.
+func (r *Runner) Run(s Stage, sender, input string) (map[string]int, error) {
+	args := append([]string{r.scriptPath()}, s.Args(r.WorkDir, sender, input)...)
+	cmd := exec.Command(r.Python, args...)
+	cmd.Dir = r.WorkDir
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Name(), err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Name(), err)
+	}
+
+	var output []byte
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := scanner.Text()
+		output = append(output, line...)
+		output = append(output, '\n')
+		if r.Progress != nil {
+			r.Progress.Line(s.Name(), line)
+		}
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Name(), err)
+	}
+
+	stats, err := s.ParseStats(output)
+	if err != nil {
+		return nil, fmt.Errorf("%s: parsing stats: %w", s.Name(), err)
+	}
+	if err := s.OnComplete(r.WorkDir); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.Name(), err)
+	}
+	if r.Progress != nil {
+		r.Progress.StageDone(s.Name(), stats)
+	}
+	return stats, nil
+}
+
+// Pipeline composes stages and runs them in order, threading each stage's
+// conventional output file as the next stage's input.
+type Pipeline struct {
+	Runner *Runner
+	Stages []Stage
+}
+
+// Run executes every stage in order, stopping at the first error.
+func (p *Pipeline) Run(sender, input string) (Results, error) {
+	results := Results{Stats: make(map[string]map[string]int)}
+	for _, s := range p.Stages {
+		stats, err := p.Runner.Run(s, sender, input)
+		if err != nil {
+			return results, err
+		}
+		results.Stats[s.Name()] = stats
+		input = "" // later stages resolve their own conventional input file
+	}
+	return results, nil
+}
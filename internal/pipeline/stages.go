@@ -0,0 +1,104 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// parseJSONStats scans output for the first line that looks like a JSON
+// object, matching the --json-stats convention every pipeline.py subcommand
+// follows.
+func parseJSONStats(output []byte) (map[string]int, error) {
+	for _, line := range strings.Split(string(output), "\n") {
+		if strings.HasPrefix(line, "{") {
+			var stats map[string]int
+			if err := json.Unmarshal([]byte(line), &stats); err == nil {
+				return stats, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no json stats line found in output")
+}
+
+// ImportStage strips attachments from the raw mbox/zip export.
+type ImportStage struct{}
+
+func (ImportStage) Name() string { return "import" }
+
+func (ImportStage) Args(workDir, sender, input string) []string {
+	return []string{"import", input, "--out", "emails_raw.json", "--json-stats"}
+}
+
+func (ImportStage) ParseStats(output []byte) (map[string]int, error) {
+	return parseJSONStats(output)
+}
+
+func (ImportStage) OnComplete(workDir string) error { return nil }
+
+// ConvertStage turns the imported export into one JSON object per line.
+type ConvertStage struct{}
+
+func (ConvertStage) Name() string { return "convert" }
+
+func (ConvertStage) Args(workDir, sender, input string) []string {
+	convertInput := input
+	if convertInput == "" {
+		convertInput = filepath.Join(workDir, "emails_raw.json")
+	}
+	return []string{"convert", convertInput, "--out", "emails.jsonl", "--json-stats"}
+}
+
+func (ConvertStage) ParseStats(output []byte) (map[string]int, error) {
+	return parseJSONStats(output)
+}
+
+func (ConvertStage) OnComplete(workDir string) error { return nil }
+
+// CleanStage anonymizes PII with Presidio, keeping the sender's own emails
+// when sender is set.
+type CleanStage struct{}
+
+func (CleanStage) Name() string { return "clean" }
+
+func (CleanStage) Args(workDir, sender, input string) []string {
+	args := []string{"clean", "emails.jsonl", "--out", "cleaned_emails.json", "--json-stats"}
+	if sender != "" {
+		args = append(args, "--sender", sender)
+	}
+	return args
+}
+
+func (CleanStage) ParseStats(output []byte) (map[string]int, error) {
+	return parseJSONStats(output)
+}
+
+func (CleanStage) OnComplete(workDir string) error { return nil }
+
+// CurateStage scores and shortlists emails, then copies the result to the
+// user's Desktop so it's easy to find.
+type CurateStage struct{}
+
+func (CurateStage) Name() string { return "curate" }
+
+func (CurateStage) Args(workDir, sender, input string) []string {
+	return []string{"curate", "cleaned_emails.json", "--out", "style_shortlist.csv", "--json-stats"}
+}
+
+func (CurateStage) ParseStats(output []byte) (map[string]int, error) {
+	return parseJSONStats(output)
+}
+
+func (CurateStage) OnComplete(workDir string) error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(workDir, "style_shortlist.csv"))
+	if err != nil {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(home, "Desktop", "style_shortlist.csv"), data, 0644)
+}
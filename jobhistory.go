@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// appendStageLog appends one full (untruncated) line of stage output to
+// workDir/logs/<stage>.log, creating the logs directory on first use.
+// Best-effort: a failure here shouldn't interrupt the running stage.
+func appendStageLog(workDir string, s stage, line string) {
+	dir := filepath.Join(workDir, "logs")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+	f, err := os.OpenFile(filepath.Join(dir, stageCacheName(s)+".log"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	f.WriteString(line + "\n")
+}
+
+// loadJobLog stitches together a job's per-stage log files, in pipeline
+// order, with a header line marking where each stage's output starts.
+func loadJobLog(job Job) []string {
+	var lines []string
+	for _, s := range []stage{stageImport, stageConvert, stageClean, stageCurate} {
+		data, err := os.ReadFile(filepath.Join(job.WorkDir, "logs", stageCacheName(s)+".log"))
+		if err != nil {
+			continue
+		}
+		lines = append(lines, "=== "+strings.ToUpper(stageCacheName(s))+" ===")
+		lines = append(lines, strings.Split(strings.TrimRight(string(data), "\n"), "\n")...)
+	}
+	if len(lines) == 0 {
+		lines = []string{"(no persisted log output for this job)"}
+	}
+	return lines
+}
+
+// deleteJob removes a job from jobs.json; the caller is responsible for
+// removing its workDir on disk.
+func deleteJob(workDir string) {
+	jobs := loadJobs()
+	kept := jobs[:0]
+	for _, j := range jobs {
+		if j.WorkDir != workDir {
+			kept = append(kept, j)
+		}
+	}
+	data, err := json.MarshalIndent(kept, "", "  ")
+	if err != nil {
+		return
+	}
+	os.WriteFile(getJobsFile(), data, 0644)
+}
+
+// openPath shells out to the platform's "open with default app" command,
+// matching the rest of this tool's approach of wrapping a real CLI instead
+// of pulling in a cross-platform binding (see keychainSet/keychainGet).
+func openPath(path string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", path).Start()
+	case "linux":
+		return exec.Command("xdg-open", path).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", path).Start()
+	}
+	return fmt.Errorf("open: unsupported platform %s", runtime.GOOS)
+}
+
+// jobHistoryMode toggles between the job list and a selected job's log.
+type jobHistoryMode int
+
+const (
+	jobHistoryModeList jobHistoryMode = iota
+	jobHistoryModeDetail
+)
+
+// jobHistoryModel is a Bubble Tea sub-model embedded in the main model for
+// screenJobHistory. It owns the job list, the selected job's scrollable log
+// viewport, and an optional regex filter over that log.
+type jobHistoryModel struct {
+	mode     jobHistoryMode
+	jobs     []Job
+	cursor   int
+	selected Job
+
+	viewport  viewport.Model
+	rawLines  []string
+	filtering bool
+	filter    textinput.Model
+	filterErr string
+}
+
+func newJobHistoryModel() jobHistoryModel {
+	jobs := loadJobs()
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Updated > jobs[j].Updated })
+
+	filter := textinput.New()
+	filter.Placeholder = "regex filter..."
+	filter.Width = 40
+
+	return jobHistoryModel{
+		mode:   jobHistoryModeList,
+		jobs:   jobs,
+		filter: filter,
+	}
+}
+
+func (m jobHistoryModel) Update(msg tea.KeyMsg) (jobHistoryModel, tea.Cmd) {
+	if m.mode == jobHistoryModeList {
+		return m.updateList(msg)
+	}
+	return m.updateDetail(msg)
+}
+
+func (m jobHistoryModel) updateList(msg tea.KeyMsg) (jobHistoryModel, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.jobs)-1 {
+			m.cursor++
+		}
+	case "enter":
+		if len(m.jobs) == 0 {
+			return m, nil
+		}
+		m.selected = m.jobs[m.cursor]
+		m.rawLines = loadJobLog(m.selected)
+		m.mode = jobHistoryModeDetail
+		m.viewport = viewport.New(66, 14)
+		m.viewport.SetContent(strings.Join(m.rawLines, "\n"))
+	case "d":
+		if len(m.jobs) == 0 {
+			return m, nil
+		}
+		job := m.jobs[m.cursor]
+		os.RemoveAll(job.WorkDir)
+		deleteJob(job.WorkDir)
+		m.jobs = loadJobs()
+		sort.Slice(m.jobs, func(i, j int) bool { return m.jobs[i].Updated > m.jobs[j].Updated })
+		if m.cursor >= len(m.jobs) {
+			m.cursor = len(m.jobs) - 1
+		}
+		if m.cursor < 0 {
+			m.cursor = 0
+		}
+	}
+	return m, nil
+}
+
+func (m jobHistoryModel) updateDetail(msg tea.KeyMsg) (jobHistoryModel, tea.Cmd) {
+	if m.filtering {
+		switch msg.String() {
+		case "enter":
+			m.filtering = false
+			m.applyFilter()
+		case "esc":
+			m.filtering = false
+			m.filter.SetValue("")
+			m.filterErr = ""
+			m.viewport.SetContent(strings.Join(m.rawLines, "\n"))
+		default:
+			var cmd tea.Cmd
+			m.filter, cmd = m.filter.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.mode = jobHistoryModeList
+	case "/":
+		m.filtering = true
+		m.filter.SetValue("")
+		m.filter.Focus()
+	case "j":
+		m.viewport.LineDown(1)
+	case "k":
+		m.viewport.LineUp(1)
+	case "ctrl+d":
+		m.viewport.HalfViewDown()
+	case "ctrl+u":
+		m.viewport.HalfViewUp()
+	case "enter":
+		openPath(filepath.Join(m.selected.WorkDir, "style_shortlist.csv"))
+	case "d":
+		os.RemoveAll(m.selected.WorkDir)
+		deleteJob(m.selected.WorkDir)
+		m.jobs = loadJobs()
+		sort.Slice(m.jobs, func(i, j int) bool { return m.jobs[i].Updated > m.jobs[j].Updated })
+		m.mode = jobHistoryModeList
+		if m.cursor >= len(m.jobs) {
+			m.cursor = len(m.jobs) - 1
+		}
+		if m.cursor < 0 {
+			m.cursor = 0
+		}
+	}
+	return m, nil
+}
+
+// applyFilter re-scopes the viewport to lines matching the regex typed into
+// m.filter, leaving the full transcript in place on an empty or bad pattern.
+func (m *jobHistoryModel) applyFilter() {
+	pattern := strings.TrimSpace(m.filter.Value())
+	if pattern == "" {
+		m.viewport.SetContent(strings.Join(m.rawLines, "\n"))
+		return
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		m.filterErr = err.Error()
+		m.viewport.SetContent(strings.Join(m.rawLines, "\n"))
+		return
+	}
+	m.filterErr = ""
+
+	var filtered []string
+	for _, line := range m.rawLines {
+		if re.MatchString(line) {
+			filtered = append(filtered, line)
+		}
+	}
+	m.viewport.SetContent(strings.Join(filtered, "\n"))
+}
+
+func (m jobHistoryModel) View() string {
+	if m.mode == jobHistoryModeList {
+		return m.viewList()
+	}
+	return m.viewDetail()
+}
+
+func (m jobHistoryModel) viewList() string {
+	if len(m.jobs) == 0 {
+		return dimStyle.Render("No past jobs yet") + "\n\n" + dimStyle.Render("esc back")
+	}
+
+	var content string
+	for i, job := range m.jobs {
+		cursor := "  "
+		style := normalStyle
+		if i == m.cursor {
+			cursor = "▸ "
+			style = selectedStyle
+		}
+		line := cursor + style.Render(filepath.Base(job.Mbox))
+		line += "  " + dimStyle.Render(job.Status+" · "+job.Updated)
+		content += line + "\n"
+	}
+	content += "\n" + dimStyle.Render("↑/↓ select • enter view log • d delete • esc back")
+	return content
+}
+
+func (m jobHistoryModel) viewDetail() string {
+	content := selectedStyle.Render(filepath.Base(m.selected.Mbox)) + "  " +
+		dimStyle.Render(m.selected.Status) + "\n\n"
+	content += m.viewport.View() + "\n"
+
+	if m.filtering {
+		content += "/" + m.filter.View() + "\n"
+	} else if m.filterErr != "" {
+		content += errorStyle.Render("filter: "+m.filterErr) + "\n"
+	}
+
+	content += dimStyle.Render("j/k scroll • ctrl+u/d page • / filter • enter open CSV • d delete • esc back")
+	return content
+}
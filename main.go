@@ -2,14 +2,23 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/charmbracelet/bubbles/progress"
@@ -17,21 +26,68 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/s-b-e-n-s-o-n/voice-synth/internal/pipeline"
+	"github.com/s-b-e-n-s-o-n/voice-synth/scorer"
 )
 
 // Global program reference for sending messages from goroutines
 var program *tea.Program
 
+// rootCtx is cancelled by SIGINT/SIGTERM (see main) and is the parent of
+// every per-stage context, so an OS-level interrupt tears down whatever
+// pipeline.py subprocess is running instead of leaving it orphaned.
+var rootCtx context.Context
+var cancelRoot context.CancelFunc
+
+// gracePeriod is how long a cancelled subprocess gets to exit after SIGTERM
+// before waitWithGracefulCancel escalates to SIGKILL.
+var gracePeriod = 5 * time.Second
+
+// forceFresh (--force) skips the checkpoint.json resume check entirely and
+// starts the pipeline over, even if a matching checkpoint is found.
+var forceFresh bool
+
+// resumeFromFlag (--from) names a stage to resume from explicitly, bypassing
+// both the checkpoint's recorded stage and the artifact-detection fallback.
+var resumeFromFlag string
+
 const version = "0.5.1-alpha"
 
+// defaultWorkers is used when neither --workers nor VOICE_SYNTH_WORKERS is set.
+const defaultWorkers = 4
+
+// shardedStages fan out across the worker pool; the others stay single-process
+// since they're I/O bound on one mbox/json file rather than per-email CPU work.
+var shardedStages = map[stage]bool{
+	stageClean:  true,
+	stageCurate: true,
+}
+
+// llmProviderChoices backs the screenModelPicker list. Index must line up
+// with llmProviderAt below.
+var llmProviderChoices = []string{"Ollama (local)", "OpenAI", "Anthropic", "Skip"}
+
+func llmProviderAt(i int) scorer.Provider {
+	switch i {
+	case 0:
+		return scorer.ProviderOllama
+	case 1:
+		return scorer.ProviderOpenAI
+	case 2:
+		return scorer.ProviderAnthropic
+	}
+	return ""
+}
+
 // Colors matching the purple/green aesthetic
 var (
-	purple    = lipgloss.Color("#9370DB")
-	green     = lipgloss.Color("#00FF7F")
-	dim       = lipgloss.Color("#666666")
-	white     = lipgloss.Color("#FFFFFF")
-	red       = lipgloss.Color("#FF6B6B")
-	yellow    = lipgloss.Color("#FFD93D")
+	purple = lipgloss.Color("#9370DB")
+	green  = lipgloss.Color("#00FF7F")
+	dim    = lipgloss.Color("#666666")
+	white  = lipgloss.Color("#FFFFFF")
+	red    = lipgloss.Color("#FF6B6B")
+	yellow = lipgloss.Color("#FFD93D")
 
 	titleStyle = lipgloss.NewStyle().
 			Foreground(purple).
@@ -86,6 +142,19 @@ const (
 	screenHelp
 	screenUninstall
 	screenSetup
+	screenModelPicker
+	screenJobHistory
+	screenWatching
+)
+
+// llmConfigStep tracks progress through the screenModelPicker wizard.
+type llmConfigStep int
+
+const (
+	llmStepProvider llmConfigStep = iota
+	llmStepAPIKey
+	llmStepModel
+	llmStepPromptTemplate
 )
 
 // Pipeline stages
@@ -101,25 +170,51 @@ const (
 
 // Messages
 type (
-	setupNextMsg        struct{ step int }
-	setupCompleteMsg    struct{}
-	setupErrorMsg       struct{ err error }
-	stageCompleteMsg    struct{ stage stage; stats map[string]int }
-	stageErrorMsg       struct{ stage stage; err error }
+	setupNextMsg     struct{ step int }
+	setupCompleteMsg struct{}
+	setupErrorMsg    struct{ err error }
+	stageCompleteMsg struct {
+		stage stage
+		stats map[string]int
+	}
+	stageErrorMsg struct {
+		stage stage
+		err   error
+	}
 	pipelineCompleteMsg struct{ results map[string]map[string]int }
 	ownerDetectedMsg    struct{ email string }
 	logUpdateMsg        struct{ line string }
-	tickMsg             time.Time
+	llmScoreCompleteMsg struct{ scored int }
+	llmScoreErrorMsg    struct{ err error }
+	shardProgressMsg    struct {
+		shardID string
+		status  shardStatus
+	}
+	tickMsg time.Time
+
+	// watchTriggerMsg arrives once per debounced burst of filesystem events
+	// on the watched input file/directory (see startWatcher), and kicks off
+	// another pipeline run from screenWatching.
+	watchTriggerMsg struct{}
 )
 
+// shardStatus tracks one shard worker's progress through the current stage.
+type shardStatus struct {
+	downloaded int
+	processed  int
+	total      int
+	done       bool
+	err        string
+}
+
 // Model
 type model struct {
-	screen       screen
-	menuCursor   int
-	menuItems    []string
-	textInput    textinput.Model
-	spinner      spinner.Model
-	progress     progress.Model
+	screen     screen
+	menuCursor int
+	menuItems  []string
+	textInput  textinput.Model
+	spinner    spinner.Model
+	progress   progress.Model
 
 	// Pipeline state
 	inputFile    string
@@ -129,28 +224,71 @@ type model struct {
 	stageStats   map[stage]map[string]int
 	results      map[string]map[string]int
 	failedStage  stage    // -1 if no failure
-	logLines     []string // Rolling log output
+	logLines     []string // Rolling in-progress tail; full history lives on disk, see jobHistory
+
+	// Worker pool state (Clean/Curate stages)
+	workers       int
+	shardProgress map[string]shardStatus
+	shardOrder    []string
+	cancelChannel chan struct{}
+	// cancelOnce guards the close of cancelChannel: dispatchStage's timeout
+	// timer and the esc/ctrl+c/q key handlers can all race to close it from
+	// different goroutines, and closing a channel twice panics.
+	cancelOnce *sync.Once
+
+	// Structured event protocol state for the running stage
+	stageDone  int
+	stageTotal int
+	stagePhase string
+	liveStats  map[string]int
+	piiHits    map[string]int
+
+	// stageDeadline is when the current stage's timeout will fire, used to
+	// render a countdown in the header; zero if no stage is running.
+	stageDeadline time.Time
+
+	// Watch mode (--watch): re-runs the pipeline whenever inputFile changes.
+	// watchEvents/watchCancel are nil until the first run completes.
+	watchEvents <-chan struct{}
+	watchCancel context.CancelFunc
+	lastRunAt   time.Time
+
+	// Fuzzy file picker sub-model (screenFilePicker)
+	filePicker filePickerModel
+
+	// Past-jobs browser sub-model (screenJobHistory)
+	jobHistory jobHistoryModel
+
+	// LLM scoring config, set via screenModelPicker and persisted across runs
+	llmConfigStep     llmConfigStep
+	llmProviderCursor int
+	llmProvider       scorer.Provider
+	llmAPIKey         string
+	llmModel          string
+	llmPromptTemplate string
+	llmScoringEnabled bool
+	llmScoreStatus    string
 
 	// Setup state
-	setupStep       int
-	setupSteps      []string
-	setupDone       bool
+	setupStep  int
+	setupSteps []string
+	setupDone  bool
 
 	// Status message (for various screens)
-	statusMsg    string
+	statusMsg string
 
 	// Resume state
 	incompleteJob *Job
 
 	// Error state
-	errMsg       string
+	errMsg string
 
 	// Screen dimensions
-	width        int
-	height       int
+	width  int
+	height int
 }
 
-func initialModel() model {
+func initialModel(workers int) model {
 	ti := textinput.New()
 	ti.Placeholder = "Drag file here or type path..."
 	ti.Focus()
@@ -163,15 +301,19 @@ func initialModel() model {
 	p := progress.New(progress.WithDefaultGradient())
 
 	return model{
-		screen:      screenSetup,
-		menuItems:   []string{"Get Started", "Help", "Uninstall", "Quit"},
-		textInput:   ti,
-		spinner:     s,
-		progress:    p,
-		stageStats:  make(map[stage]map[string]int),
-		failedStage: -1,
-		setupStep:   -1,
-		setupSteps:  []string{
+		screen:        screenSetup,
+		menuItems:     []string{"Get Started", "Configure LLM Scoring", "Job History", "Help", "Uninstall", "Quit"},
+		textInput:     ti,
+		spinner:       s,
+		progress:      p,
+		stageStats:    make(map[stage]map[string]int),
+		failedStage:   -1,
+		workers:       workers,
+		shardProgress: make(map[string]shardStatus),
+		liveStats:     make(map[string]int),
+		piiHits:       make(map[string]int),
+		setupStep:     -1,
+		setupSteps: []string{
 			"Creating Python environment",
 			"Installing core libraries",
 			"Installing Presidio (PII detection)",
@@ -191,8 +333,48 @@ func (m model) Init() tea.Cmd {
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// screenFilePicker delegates to its own sub-model, which owns both
+		// the fuzzy list and the paste-path fallback.
+		if m.screen == screenFilePicker {
+			switch msg.String() {
+			case "ctrl+c":
+				return m, tea.Quit
+			case "esc":
+				m.screen = screenMainMenu
+				m.errMsg = ""
+				return m, nil
+			default:
+				var cmd tea.Cmd
+				m.filePicker, cmd = m.filePicker.Update(msg)
+				return m, cmd
+			}
+		}
+
+		// screenJobHistory delegates to its own sub-model, which owns the
+		// job list, the scrollable log viewport, and regex filtering.
+		if m.screen == screenJobHistory {
+			switch msg.String() {
+			case "ctrl+c":
+				return m, tea.Quit
+			case "esc":
+				if m.jobHistory.mode == jobHistoryModeDetail {
+					var cmd tea.Cmd
+					m.jobHistory, cmd = m.jobHistory.Update(msg)
+					return m, cmd
+				}
+				m.screen = screenMainMenu
+				m.errMsg = ""
+				return m, nil
+			default:
+				var cmd tea.Cmd
+				m.jobHistory, cmd = m.jobHistory.Update(msg)
+				return m, cmd
+			}
+		}
+
 		// On screens with text input, let textInput handle most keys
-		if m.screen == screenFilePicker || m.screen == screenSenderFilter || m.screen == screenUninstall {
+		if m.screen == screenSenderFilter || m.screen == screenUninstall ||
+			(m.screen == screenModelPicker && m.llmConfigStep != llmStepProvider) {
 			switch msg.String() {
 			case "ctrl+c":
 				return m, tea.Quit
@@ -223,7 +405,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case tickMsg:
-		return m, tea.Tick(100*time.Millisecond, func(t time.Time) tea.Msg {
+		// Drives the countdown in viewProgress's header; only needed while a
+		// stage is actually running, so it stops rescheduling itself once the
+		// user leaves the progress screen instead of ticking forever.
+		if m.screen != screenProgress {
+			return m, nil
+		}
+		return m, tea.Tick(time.Second, func(t time.Time) tea.Msg {
 			return tickMsg(t)
 		})
 
@@ -237,7 +425,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Check for incomplete job
 		m.incompleteJob = getIncompleteJob()
 		if m.incompleteJob != nil {
-			m.menuItems = []string{"Resume previous", "Get Started", "Help", "Uninstall", "Quit"}
+			m.menuItems = []string{"Resume previous", "Get Started", "Configure LLM Scoring", "Job History", "Help", "Uninstall", "Quit"}
+		}
+		if cfg, ok := loadLLMConfig(); ok {
+			m.llmProvider = scorer.Provider(cfg.Provider)
+			m.llmModel = cfg.Model
+			m.llmPromptTemplate = cfg.PromptTemplate
+			m.llmScoringEnabled = true
 		}
 		return m, nil
 
@@ -250,6 +444,25 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.statusMsg = fmt.Sprintf("Detected: %s", msg.email)
 		return m, nil
 
+	case filePickerSelectedMsg:
+		path := cleanPath(msg.path)
+		if path == "" {
+			m.errMsg = "Please enter a file path"
+			return m, nil
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			m.errMsg = fmt.Sprintf("File not found: %s", path)
+			return m, nil
+		}
+		m.inputFile = path
+		m.errMsg = ""
+		m.screen = screenSenderFilter
+		m.textInput.SetValue("")
+		m.textInput.Placeholder = "Enter your email address..."
+		m.textInput.Focus()
+		m.statusMsg = "Detecting your email address..."
+		return m, detectOwnerEmail(rootCtx, path)
+
 	case logUpdateMsg:
 		// Add line to rolling log (keep last 8 lines)
 		m.logLines = append(m.logLines, msg.line)
@@ -258,25 +471,124 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case shardProgressMsg:
+		if _, ok := m.shardProgress[msg.shardID]; !ok {
+			m.shardOrder = append(m.shardOrder, msg.shardID)
+		}
+		m.shardProgress[msg.shardID] = msg.status
+		return m, nil
+
+	case progressEventMsg:
+		m.stageDone = msg.done
+		m.stageTotal = msg.total
+		m.stagePhase = msg.phase
+		return m, nil
+
+	case statEventMsg:
+		// Live running counts, kept separate from stageStats so a stage in
+		// progress doesn't get mistaken for a completed one in viewProgress.
+		m.liveStats[msg.key] = msg.value
+		return m, nil
+
+	case logEventMsg:
+		line := msg.msg
+		if msg.level != "" && msg.level != "info" {
+			line = strings.ToUpper(msg.level) + ": " + line
+		}
+		m.logLines = append(m.logLines, line)
+		if len(m.logLines) > 8 {
+			m.logLines = m.logLines[len(m.logLines)-8:]
+		}
+		return m, nil
+
+	case piiHitEventMsg:
+		m.piiHits[msg.entity]++
+		return m, nil
+
 	case stageCompleteMsg:
 		m.stageStats[msg.stage] = msg.stats
+		writeCheckpoint(m.workDir, m.inputFile, m.sender, msg.stage, msg.stats, "completed")
 		m.currentStage = msg.stage + 1
+		m.stageDeadline = time.Time{}
 		m.logLines = nil // Clear log for next stage
+		m.shardProgress = make(map[string]shardStatus)
+		m.shardOrder = nil
+		m.stageDone = 0
+		m.stageTotal = 0
+		m.stagePhase = ""
+		m.liveStats = make(map[string]int)
+		m.piiHits = make(map[string]int)
 		if m.currentStage < stageDone {
-			return m, runPipelineStage(m.inputFile, m.sender, m.workDir, m.currentStage)
+			return m, m.dispatchStage(m.currentStage)
 		}
 		return m, nil
 
 	case stageErrorMsg:
 		m.failedStage = msg.stage
 		m.errMsg = msg.err.Error()
+		m.stageDeadline = time.Time{}
 		return m, nil
 
 	case pipelineCompleteMsg:
 		m.results = msg.results
-		m.screen = screenResults
-		// Mark job as complete
+		m.stageDeadline = time.Time{}
+		m.lastRunAt = time.Now()
+		// Mark job as complete and drop the checkpoint, since there's
+		// nothing left to resume
 		markJobComplete(m.workDir)
+		os.Remove(filepath.Join(m.workDir, "checkpoint.json"))
+
+		var cmds []tea.Cmd
+		if notifyEnabled() {
+			cmds = append(cmds, sendCompletionEmailCmd(m.workDir, m.stageStats, m.sender))
+		}
+		if m.llmScoringEnabled {
+			m.llmScoreStatus = "Scoring shortlist with " + string(m.llmProvider) + "..."
+			cmds = append(cmds, scoreShortlist(m.workDir, m.llmConfig()))
+		}
+
+		if watchFlag {
+			m.screen = screenWatching
+			if m.watchEvents == nil {
+				ctx, cancel := context.WithCancel(rootCtx)
+				events, err := startWatcher(ctx, m.inputFile)
+				if err != nil {
+					cancel()
+					m.errMsg = fmt.Sprintf("watch failed: %v", err)
+				} else {
+					m.watchCancel = cancel
+					m.watchEvents = events
+				}
+			}
+			if m.watchEvents != nil {
+				cmds = append(cmds, waitForWatchTrigger(m.watchEvents))
+			}
+		} else {
+			m.screen = screenResults
+		}
+		return m, tea.Batch(cmds...)
+
+	case watchTriggerMsg:
+		if m.screen != screenWatching {
+			return m, nil
+		}
+		m.screen = screenProgress
+		m.currentStage = stageImport
+		m.stageStats = make(map[stage]map[string]int)
+		m.failedStage = -1
+		m.errMsg = ""
+		return m, tea.Batch(
+			m.spinner.Tick,
+			tea.Tick(time.Second, func(t time.Time) tea.Msg { return tickMsg(t) }),
+			m.dispatchStage(stageImport),
+		)
+
+	case llmScoreCompleteMsg:
+		m.llmScoreStatus = fmt.Sprintf("Scored %d emails with %s", msg.scored, m.llmProvider)
+		return m, nil
+
+	case llmScoreErrorMsg:
+		m.llmScoreStatus = "LLM scoring failed: " + msg.err.Error()
 		return m, nil
 	}
 
@@ -286,7 +598,34 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "ctrl+c", "q":
+		if m.screen == screenProgress {
+			// Cancel the running stage the same way esc does, so q/ctrl+c
+			// never leaves a pipeline.py process running in the background.
+			if m.cancelChannel != nil && m.cancelOnce != nil {
+				m.cancelOnce.Do(func() { close(m.cancelChannel) })
+			}
+			if msg.String() == "ctrl+c" {
+				cancelRoot()
+				return m, tea.Quit
+			}
+			m.screen = screenMainMenu
+			m.errMsg = ""
+			return m, nil
+		}
+		if m.screen == screenWatching {
+			m.stopWatching()
+			if msg.String() == "ctrl+c" {
+				cancelRoot()
+				return m, tea.Quit
+			}
+			m.screen = screenMainMenu
+			m.errMsg = ""
+			return m, nil
+		}
 		if m.screen == screenMainMenu || m.screen == screenResults {
+			if msg.String() == "ctrl+c" {
+				cancelRoot()
+			}
 			return m, tea.Quit
 		}
 		// Go back to main menu
@@ -295,7 +634,24 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case "esc":
-		if m.screen != screenMainMenu && m.screen != screenProgress {
+		if m.screen == screenProgress {
+			// Cancel the running stage: in-flight workers are drained (and a
+			// sharded stage writes a partial checkpoint) in the background
+			// while we return to the menu.
+			if m.cancelChannel != nil && m.cancelOnce != nil {
+				m.cancelOnce.Do(func() { close(m.cancelChannel) })
+			}
+			m.screen = screenMainMenu
+			m.errMsg = ""
+			return m, nil
+		}
+		if m.screen == screenWatching {
+			m.stopWatching()
+			m.screen = screenMainMenu
+			m.errMsg = ""
+			return m, nil
+		}
+		if m.screen != screenMainMenu {
 			m.screen = screenMainMenu
 			m.errMsg = ""
 		}
@@ -305,12 +661,18 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		if m.screen == screenMainMenu && m.menuCursor > 0 {
 			m.menuCursor--
 		}
+		if m.screen == screenModelPicker && m.llmConfigStep == llmStepProvider && m.llmProviderCursor > 0 {
+			m.llmProviderCursor--
+		}
 		return m, nil
 
 	case "down", "j":
 		if m.screen == screenMainMenu && m.menuCursor < len(m.menuItems)-1 {
 			m.menuCursor++
 		}
+		if m.screen == screenModelPicker && m.llmConfigStep == llmStepProvider && m.llmProviderCursor < len(llmProviderChoices)-1 {
+			m.llmProviderCursor++
+		}
 		return m, nil
 
 	case "enter":
@@ -320,6 +682,173 @@ func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// dispatchStage starts the given stage, fanning out across the worker pool
+// for sharded stages and falling back to a single subprocess otherwise. It
+// stores the stage's new cancelChannel, cancelOnce and stageDeadline on m, so
+// the caller must return that same model value from Update.
+func (m *model) dispatchStage(s stage) tea.Cmd {
+	m.cancelChannel = make(chan struct{})
+	m.cancelOnce = &sync.Once{}
+	cancelChannel := m.cancelChannel
+	cancelOnce := m.cancelOnce
+	inputFile, sender, workDir, workers := m.inputFile, m.sender, m.workDir, m.workers
+	timeout := loadTimeoutConfig().stageTimeout(s)
+	m.stageDeadline = time.Now().Add(timeout)
+
+	var inner tea.Cmd
+	if shardedStages[s] {
+		inner = runPipelineStageConcurrent(inputFile, sender, workDir, s, workers, cancelChannel)
+	} else {
+		ctx := ctxFromCancelChannel(rootCtx, cancelChannel)
+		inner = runPipelineStage(ctx, inputFile, sender, workDir, s)
+	}
+
+	return func() tea.Msg {
+		if stats, hash, ok := tryLoadCachedStage(workDir, inputFile, sender, workers, s); ok {
+			recordShardHash(workDir, stageCacheName(s), hash)
+			if s == stageCurate {
+				pipeline.CurateStage{}.OnComplete(workDir)
+				return pipelineCompleteMsg{results: map[string]map[string]int{"curate": stats}}
+			}
+			return stageCompleteMsg{stage: s, stats: stats}
+		}
+
+		// timedOut is set by the timer below if it wins the race against
+		// inner() returning, so the cancelled-path stageErrorMsg can be
+		// relabeled as a timeout instead of a user cancellation.
+		var timedOut int32
+		timer := time.AfterFunc(timeout, func() {
+			// cancelOnce also guards the esc/ctrl+c/q key handlers' close of
+			// this same channel, so whichever of them gets there first wins
+			// and the other's close is silently skipped instead of panicking.
+			cancelOnce.Do(func() {
+				atomic.StoreInt32(&timedOut, 1)
+				close(cancelChannel)
+			})
+		})
+		msg := inner()
+		timer.Stop()
+
+		if errMsg, ok := msg.(stageErrorMsg); ok && atomic.LoadInt32(&timedOut) == 1 {
+			logFile := filepath.Join(getCacheDir(), "error.log")
+			logContent := fmt.Sprintf("Stage: %s\nTimeout: %s exceeded, process group killed\nWorkDir: %s\nTimestamp: %s\n",
+				stageCacheName(s), timeout, workDir, time.Now().UTC().Format(time.RFC3339))
+			os.WriteFile(logFile, []byte(logContent), 0644)
+			errMsg.err = fmt.Errorf("timed out after %s", timeout)
+			msg = errMsg
+		}
+
+		switch out := msg.(type) {
+		case stageCompleteMsg:
+			if hash, err := saveCachedStage(workDir, inputFile, sender, workers, s, out.stats); err == nil {
+				recordShardHash(workDir, stageCacheName(s), hash)
+			}
+		case pipelineCompleteMsg:
+			if stats, ok := out.results["curate"]; ok {
+				if hash, err := saveCachedStage(workDir, inputFile, sender, workers, stageCurate, stats); err == nil {
+					recordShardHash(workDir, stageCacheName(stageCurate), hash)
+				}
+			}
+		}
+		return msg
+	}
+}
+
+// handleModelPickerEnter advances the screenModelPicker wizard: pick a
+// provider, then (for hosted providers) an API key, then a model name, then
+// an optional prompt template override, persisting the result on the way out.
+func (m model) handleModelPickerEnter() (tea.Model, tea.Cmd) {
+	switch m.llmConfigStep {
+	case llmStepProvider:
+		choice := llmProviderChoices[m.llmProviderCursor]
+		if choice == "Skip" {
+			m.llmScoringEnabled = false
+			m.screen = screenMainMenu
+			return m, nil
+		}
+		m.llmProvider = llmProviderAt(m.llmProviderCursor)
+		m.errMsg = ""
+		if m.llmProvider == scorer.ProviderOllama {
+			// No API key needed for a local Ollama server.
+			m.llmConfigStep = llmStepModel
+			m.textInput.SetValue("llama3")
+			m.textInput.Placeholder = "Ollama model name..."
+		} else {
+			m.llmConfigStep = llmStepAPIKey
+			m.textInput.SetValue("")
+			m.textInput.Placeholder = fmt.Sprintf("%s API key...", choice)
+		}
+		m.textInput.Focus()
+		return m, nil
+
+	case llmStepAPIKey:
+		key := strings.TrimSpace(m.textInput.Value())
+		if key == "" {
+			m.errMsg = "An API key is required"
+			return m, nil
+		}
+		m.llmAPIKey = key
+		m.errMsg = ""
+		m.llmConfigStep = llmStepModel
+		m.textInput.SetValue(defaultModelFor(m.llmProvider))
+		m.textInput.Placeholder = "Model name..."
+		return m, nil
+
+	case llmStepModel:
+		model := strings.TrimSpace(m.textInput.Value())
+		if model == "" {
+			model = defaultModelFor(m.llmProvider)
+		}
+		m.llmModel = model
+		m.llmConfigStep = llmStepPromptTemplate
+		m.textInput.SetValue("")
+		m.textInput.Placeholder = "Scoring prompt template (blank = default)..."
+		return m, nil
+
+	case llmStepPromptTemplate:
+		m.llmPromptTemplate = strings.TrimSpace(m.textInput.Value())
+		m.llmScoringEnabled = true
+		if m.llmAPIKey != "" {
+			saveSecret(string(m.llmProvider), m.llmAPIKey)
+		}
+		saveLLMConfig(llmConfig{
+			Provider:       string(m.llmProvider),
+			Model:          m.llmModel,
+			PromptTemplate: m.llmPromptTemplate,
+		})
+		m.screen = screenMainMenu
+		m.statusMsg = fmt.Sprintf("LLM scoring configured: %s / %s", m.llmProvider, m.llmModel)
+		return m, nil
+	}
+	return m, nil
+}
+
+func defaultModelFor(p scorer.Provider) string {
+	switch p {
+	case scorer.ProviderOpenAI:
+		return "gpt-4o-mini"
+	case scorer.ProviderAnthropic:
+		return "claude-3-5-haiku-latest"
+	default:
+		return "llama3"
+	}
+}
+
+// llmConfig returns the scorer.Config for the currently configured provider,
+// looking up the API key from the secrets store.
+func (m model) llmConfig() scorer.Config {
+	apiKey := m.llmAPIKey
+	if apiKey == "" {
+		apiKey, _ = loadSecret(string(m.llmProvider))
+	}
+	return scorer.Config{
+		Provider:       m.llmProvider,
+		APIKey:         apiKey,
+		Model:          m.llmModel,
+		PromptTemplate: m.llmPromptTemplate,
+	}
+}
+
 func (m model) handleEnter() (tea.Model, tea.Cmd) {
 	switch m.screen {
 	case screenMainMenu:
@@ -331,33 +860,46 @@ func (m model) handleEnter() (tea.Model, tea.Cmd) {
 				m.workDir = m.incompleteJob.WorkDir
 				m.screen = screenProgress
 				m.errMsg = ""
-				// Determine which stage to resume from
+
+				if forceFresh || !checkpointMatches(m.workDir, m.inputFile) {
+					// --force, or no checkpoint matching the current input
+					// file: don't trust leftover intermediates, start clean.
+					wipeIntermediates(m.workDir)
+				}
+
 				resumeStage := stageImport
-				if _, err := os.Stat(filepath.Join(m.workDir, "cleaned_emails.json")); err == nil {
+				if from, ok := stageFromName(resumeFromFlag); ok {
+					resumeStage = from
+				} else if _, err := os.Stat(filepath.Join(m.workDir, "cleaned_emails.json")); err == nil {
 					resumeStage = stageCurate
-					// Mark prior stages as complete
-					m.stageStats[stageImport] = map[string]int{"resumed": 1}
-					m.stageStats[stageConvert] = map[string]int{"resumed": 1}
-					m.stageStats[stageClean] = map[string]int{"resumed": 1}
 				} else if _, err := os.Stat(filepath.Join(m.workDir, "emails.jsonl")); err == nil {
 					resumeStage = stageClean
-					m.stageStats[stageImport] = map[string]int{"resumed": 1}
-					m.stageStats[stageConvert] = map[string]int{"resumed": 1}
 				} else if _, err := os.Stat(filepath.Join(m.workDir, "emails_raw.json")); err == nil {
 					resumeStage = stageConvert
-					m.stageStats[stageImport] = map[string]int{"resumed": 1}
 				}
+				// Mark every stage before resumeStage as already done
+				for s := stageImport; s < resumeStage; s++ {
+					m.stageStats[s] = map[string]int{"resumed": 1}
+				}
+
 				m.currentStage = resumeStage
 				return m, tea.Batch(
 					m.spinner.Tick,
-					runPipelineStage(m.inputFile, m.sender, m.workDir, resumeStage),
+					tea.Tick(time.Second, func(t time.Time) tea.Msg { return tickMsg(t) }),
+					m.dispatchStage(resumeStage),
 				)
 			}
 		case "Get Started":
 			m.screen = screenFilePicker
-			m.textInput.SetValue("")
-			m.textInput.Placeholder = "Drag file here or type path..."
-			m.textInput.Focus()
+			m.filePicker = newFilePickerModel()
+		case "Configure LLM Scoring":
+			m.screen = screenModelPicker
+			m.llmConfigStep = llmStepProvider
+			m.llmProviderCursor = 0
+			m.errMsg = ""
+		case "Job History":
+			m.screen = screenJobHistory
+			m.jobHistory = newJobHistoryModel()
 		case "Help":
 			m.screen = screenHelp
 		case "Uninstall":
@@ -370,24 +912,6 @@ func (m model) handleEnter() (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		}
 
-	case screenFilePicker:
-		path := cleanPath(m.textInput.Value())
-		if path == "" {
-			m.errMsg = "Please enter a file path"
-			return m, nil
-		}
-		if _, err := os.Stat(path); os.IsNotExist(err) {
-			m.errMsg = fmt.Sprintf("File not found: %s", path)
-			return m, nil
-		}
-		m.inputFile = path
-		m.errMsg = ""
-		m.screen = screenSenderFilter
-		m.textInput.SetValue("")
-		m.textInput.Placeholder = "Enter your email address..."
-		m.statusMsg = "Detecting your email address..."
-		return m, detectOwnerEmail(path)
-
 	case screenSenderFilter:
 		sender := strings.TrimSpace(m.textInput.Value())
 		if sender == "" {
@@ -403,9 +927,13 @@ func (m model) handleEnter() (tea.Model, tea.Cmd) {
 		saveJob(m.inputFile, m.workDir, "in_progress", m.sender)
 		return m, tea.Batch(
 			m.spinner.Tick,
-			runPipelineStage(m.inputFile, m.sender, m.workDir, stageImport),
+			tea.Tick(time.Second, func(t time.Time) tea.Msg { return tickMsg(t) }),
+			m.dispatchStage(stageImport),
 		)
 
+	case screenModelPicker:
+		return m.handleModelPickerEnter()
+
 	case screenResults, screenHelp:
 		m.screen = screenMainMenu
 
@@ -449,6 +977,12 @@ func (m model) View() string {
 		return m.viewHelp()
 	case screenUninstall:
 		return m.viewUninstall()
+	case screenModelPicker:
+		return m.viewModelPicker()
+	case screenJobHistory:
+		return m.viewJobHistory()
+	case screenWatching:
+		return m.viewWatching()
 	}
 	return ""
 }
@@ -504,7 +1038,15 @@ func (m model) viewMainMenu() string {
 		// Show file info for resume option
 		if item == "Resume previous" && m.incompleteJob != nil {
 			filename := filepath.Base(m.incompleteJob.Mbox)
-			line += " " + dimStyle.Render("("+filename+")")
+			detail := filename
+			if cp, ok := loadCheckpoint(m.incompleteJob.WorkDir); ok && cp.Status == "completed" && checkpointMatches(m.incompleteJob.WorkDir, m.incompleteJob.Mbox) {
+				if kept, ok := cp.Stats["kept"]; ok {
+					detail = fmt.Sprintf("%s, last completed: %s, %d rows", filename, stageCacheName(stage(cp.Stage)), kept)
+				} else {
+					detail = fmt.Sprintf("%s, last completed: %s", filename, stageCacheName(stage(cp.Stage)))
+				}
+			}
+			line += " " + dimStyle.Render("("+detail+")")
 		}
 		content += line + "\n"
 	}
@@ -518,17 +1060,14 @@ func (m model) viewMainMenu() string {
 func (m model) viewFilePicker() string {
 	content := titleStyle.Render("Select Input File") + "\n"
 	content += subtitleStyle.Render("Drop your Google Takeout export (.mbox, folder, or .zip)") + "\n\n"
-	content += m.textInput.View() + "\n\n"
-	content += dimStyle.Render("Drag from Finder into this window, then press Enter") + "\n"
+	content += m.filePicker.View() + "\n"
 
 	if m.errMsg != "" {
 		content += "\n" + errorStyle.Render(m.errMsg)
 	}
 
-	content += "\n" + dimStyle.Render("enter continue • esc back")
-
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
-		menuStyle.Render(content))
+		menuStyle.Width(60).Render(content))
 }
 
 func (m model) viewSenderFilter() string {
@@ -588,6 +1127,13 @@ func (m model) viewProgress() string {
 			icon = m.spinner.View()
 			style = stageRunningStyle
 			text = st.name + "..."
+			if !m.stageDeadline.IsZero() {
+				remaining := time.Until(m.stageDeadline)
+				if remaining < 0 {
+					remaining = 0
+				}
+				text += fmt.Sprintf(" [%02d:%02d remaining]", int(remaining.Minutes()), int(remaining.Seconds())%60)
+			}
 		} else {
 			// Pending
 			icon = "○"
@@ -598,6 +1144,51 @@ func (m model) viewProgress() string {
 		content += fmt.Sprintf("%s %s\n", icon, style.Render(text))
 	}
 
+	// Overall progress bar for the running stage, driven by real done/total
+	// counts reported over the NDJSON event protocol.
+	if m.failedStage == -1 && m.stageTotal > 0 {
+		content += "\n" + m.progress.ViewAs(float64(m.stageDone)/float64(m.stageTotal))
+		counter := fmt.Sprintf("%d / %d", m.stageDone, m.stageTotal)
+		if m.stagePhase != "" {
+			counter += " (" + m.stagePhase + ")"
+		}
+		content += "\n" + dimStyle.Render(counter)
+		content += "\n"
+	}
+
+	// Live PII-hit counter panel
+	if len(m.piiHits) > 0 {
+		content += "\n" + selectedStyle.Render("PII redacted:") + "\n"
+		entities := make([]string, 0, len(m.piiHits))
+		for entity := range m.piiHits {
+			entities = append(entities, entity)
+		}
+		sort.Strings(entities)
+		for _, entity := range entities {
+			content += dimStyle.Render(fmt.Sprintf("  %s: %d\n", entity, m.piiHits[entity]))
+		}
+	}
+
+	// Per-shard progress bars for the currently running worker pool
+	if len(m.shardOrder) > 0 {
+		content += "\n"
+		for _, id := range m.shardOrder {
+			st := m.shardProgress[id]
+			var bar string
+			switch {
+			case st.err != "":
+				bar = errorStyle.Render("✗ " + st.err)
+			case st.done:
+				bar = stageCompleteStyle.Render(m.progress.ViewAs(1.0))
+			case st.total > 0:
+				bar = m.progress.ViewAs(float64(st.processed) / float64(st.total))
+			default:
+				bar = dimStyle.Render("waiting...")
+			}
+			content += fmt.Sprintf("  %s %s\n", dimStyle.Render(id), bar)
+		}
+	}
+
 	// Log box - show rolling output
 	content += "\n"
 	logBoxStyle := lipgloss.NewStyle().
@@ -619,6 +1210,8 @@ func (m model) viewProgress() string {
 
 	if m.errMsg != "" {
 		content += "\n" + dimStyle.Render("esc to go back")
+	} else {
+		content += "\n" + dimStyle.Render("esc to cancel")
 	}
 
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
@@ -653,6 +1246,15 @@ func (m model) viewResults() string {
 		content += fmt.Sprintf("Curate     %5d    %5d    %5d\n",
 			stats["total_input"], stats["shortlisted"], stats["total_input"]-stats["shortlisted"])
 	}
+	if stats, ok := m.stageStats[stageClean]; ok {
+		if removed := stats["duplicates_removed"]; removed > 0 {
+			content += fmt.Sprintf("Dedup      %5d near-duplicate drafts removed (MinHash)\n", removed)
+		}
+	}
+
+	if m.llmScoreStatus != "" {
+		content += "\n" + dimStyle.Render(m.llmScoreStatus) + "\n"
+	}
 
 	content += "\n" + dimStyle.Render("Open the CSV in a spreadsheet to review") + "\n"
 	content += "\n" + dimStyle.Render("enter done • q quit")
@@ -661,6 +1263,14 @@ func (m model) viewResults() string {
 		menuStyle.Width(60).Render(content))
 }
 
+func (m model) viewJobHistory() string {
+	content := titleStyle.Render("Job History") + "\n\n"
+	content += m.jobHistory.View()
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+		menuStyle.Width(70).Render(content))
+}
+
 func (m model) viewHelp() string {
 	content := titleStyle.Render("Help") + "\n\n"
 	content += selectedStyle.Render("Pipeline Stages") + "\n"
@@ -702,15 +1312,57 @@ func (m model) viewUninstall() string {
 		menuStyle.Render(content))
 }
 
+func (m model) viewModelPicker() string {
+	content := titleStyle.Render("LLM Scoring") + "\n"
+	content += subtitleStyle.Render("Score curated emails with an LLM and add an llm_score column") + "\n\n"
+
+	switch m.llmConfigStep {
+	case llmStepProvider:
+		for i, choice := range llmProviderChoices {
+			cursor := "  "
+			style := normalStyle
+			if i == m.llmProviderCursor {
+				cursor = "▸ "
+				style = selectedStyle
+			}
+			content += cursor + style.Render(choice) + "\n"
+		}
+		content += "\n" + dimStyle.Render("↑/↓ navigate • enter select • esc back")
+
+	case llmStepAPIKey:
+		content += dimStyle.Render(fmt.Sprintf("Provider: %s", m.llmProvider)) + "\n\n"
+		content += m.textInput.View() + "\n"
+		if m.errMsg != "" {
+			content += "\n" + errorStyle.Render(m.errMsg)
+		}
+		content += "\n" + dimStyle.Render("enter continue • esc back")
+
+	case llmStepModel, llmStepPromptTemplate:
+		content += dimStyle.Render(fmt.Sprintf("Provider: %s", m.llmProvider)) + "\n\n"
+		content += m.textInput.View() + "\n"
+		if m.errMsg != "" {
+			content += "\n" + errorStyle.Render(m.errMsg)
+		}
+		content += "\n" + dimStyle.Render("enter continue • esc back")
+	}
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+		menuStyle.Render(content))
+}
+
 // Job tracking for resume feature
 
 type Job struct {
-	Mbox      string `json:"mbox"`
-	WorkDir   string `json:"work_dir"`
-	Status    string `json:"status"`
-	Sender    string `json:"sender"`
-	Started   string `json:"started"`
-	Updated   string `json:"updated"`
+	Mbox    string `json:"mbox"`
+	WorkDir string `json:"work_dir"`
+	Status  string `json:"status"`
+	Sender  string `json:"sender"`
+	Started string `json:"started"`
+	Updated string `json:"updated"`
+	// ShardHashes records the content-cache key saved for each completed
+	// stage (keyed by stageCacheName), so a resume can confirm the
+	// intermediate file on disk hasn't drifted since it was cached.
+	ShardHashes map[string]string `json:"shard_hashes,omitempty"`
 }
 
 func getJobsFile() string {
@@ -781,11 +1433,26 @@ func getIncompleteJob() *Job {
 		if _, err := os.Stat(filepath.Join(job.WorkDir, "style_shortlist.csv")); err == nil {
 			continue
 		}
-		// Check for intermediate files
-		for _, f := range []string{"emails_raw.json", "emails.jsonl", "cleaned_emails.json"} {
-			if _, err := os.Stat(filepath.Join(job.WorkDir, f)); err == nil {
-				return &job
+		// Check for intermediate files, validating against the cached copy
+		// under the recorded cache key (if any) so a tampered or truncated
+		// file doesn't get offered as a resume point.
+		for _, f := range []struct{ file, stageName string }{
+			{"emails_raw.json", "import"},
+			{"emails.jsonl", "convert"},
+			{"cleaned_emails.json", "clean"},
+		} {
+			path := filepath.Join(job.WorkDir, f.file)
+			if _, err := os.Stat(path); err != nil {
+				continue
+			}
+			if key, ok := job.ShardHashes[f.stageName]; ok {
+				got, err := sha256File(path)
+				want, werr := sha256File(filepath.Join(shardCacheDir(key), f.file))
+				if err != nil || werr != nil || got != want {
+					continue
+				}
 			}
+			return &job
 		}
 	}
 	return nil
@@ -804,6 +1471,42 @@ func markJobComplete(workDir string) {
 	os.WriteFile(getJobsFile(), data, 0644)
 }
 
+// LLM scoring config, persisted so "Configure LLM Scoring" only needs to
+// happen once per machine.
+
+type llmConfig struct {
+	Provider       string `json:"provider"`
+	Model          string `json:"model"`
+	PromptTemplate string `json:"prompt_template"`
+}
+
+func getLLMConfigFile() string {
+	return filepath.Join(getCacheDir(), "llm_config.json")
+}
+
+func saveLLMConfig(cfg llmConfig) error {
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(getCacheDir(), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(getLLMConfigFile(), data, 0644)
+}
+
+func loadLLMConfig() (llmConfig, bool) {
+	data, err := os.ReadFile(getLLMConfigFile())
+	if err != nil {
+		return llmConfig{}, false
+	}
+	var cfg llmConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return llmConfig{}, false
+	}
+	return cfg, true
+}
+
 // Helper functions
 
 func cleanPath(path string) string {
@@ -924,13 +1627,14 @@ func checkPythonSetup() tea.Msg {
 	return setupNextMsg{setupStepVenv}
 }
 
-func detectOwnerEmail(inputFile string) tea.Cmd {
+func detectOwnerEmail(ctx context.Context, inputFile string) tea.Cmd {
 	return func() tea.Msg {
 		python := getVenvPython()
 		scriptDir := getScriptDir()
 		pipelineScript := filepath.Join(scriptDir, "pipeline.py")
 
-		cmd := exec.Command(python, pipelineScript, "detect-owner", inputFile)
+		cmd := exec.CommandContext(ctx, python, pipelineScript, "detect-owner", inputFile)
+		setpgid(cmd)
 		output, err := cmd.Output()
 		if err != nil {
 			return ownerDetectedMsg{email: ""}
@@ -941,34 +1645,81 @@ func detectOwnerEmail(inputFile string) tea.Cmd {
 	}
 }
 
-func runPipelineStage(inputFile, sender, workDir string, s stage) tea.Cmd {
+// ctxFromCancelChannel derives a context that's cancelled when either root
+// is done (process-level SIGINT/SIGTERM) or cancelChannel is closed (the
+// user backed out of the running stage), so callers only need to check one
+// thing.
+func ctxFromCancelChannel(root context.Context, cancelChannel chan struct{}) context.Context {
+	ctx, cancel := context.WithCancel(root)
+	go func() {
+		select {
+		case <-cancelChannel:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx
+}
+
+// waitWithGracefulCancel waits for an already-started cmd to exit. If ctx is
+// cancelled first, it signals cmd's process group with SIGTERM and gives it
+// gracePeriod to exit before escalating to SIGKILL.
+func waitWithGracefulCancel(ctx context.Context, cmd *exec.Cmd) error {
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		killGroup(cmd, syscall.SIGTERM)
+		select {
+		case err := <-done:
+			return err
+		case <-time.After(gracePeriod):
+			killGroup(cmd, syscall.SIGKILL)
+			return <-done
+		}
+	}
+}
+
+func runPipelineStage(ctx context.Context, inputFile, sender, workDir string, s stage) tea.Cmd {
 	return func() tea.Msg {
 		python := getVenvPython()
 		scriptDir := getScriptDir()
 		pipelineScript := filepath.Join(scriptDir, "pipeline.py")
 
-		var args []string
+		// Args/stats-parsing/OnComplete come from internal/pipeline's Stage
+		// definitions, the same ones cmd/voice-synth-cli runs through
+		// pipeline.Runner, so the two entrypoints can't drift on what
+		// subcommand and flags a stage needs or how its --json-stats line is
+		// read. The process management below stays here rather than going
+		// through pipeline.Runner.Run: this TUI path also has to support
+		// context cancellation with a graceful kill, the NDJSON live-progress
+		// protocol, and checkpointing, none of which Runner/Progress model.
+		var stg pipeline.Stage
+		var input string
 		switch s {
 		case stageImport:
-			args = []string{pipelineScript, "import", inputFile, "--out", "emails_raw.json", "--json-stats"}
+			stg, input = pipeline.ImportStage{}, inputFile
 		case stageConvert:
 			// Use emails_raw.json if it exists, otherwise use inputFile
 			convertInput := filepath.Join(workDir, "emails_raw.json")
 			if _, err := os.Stat(convertInput); os.IsNotExist(err) {
 				convertInput = inputFile
 			}
-			args = []string{pipelineScript, "convert", convertInput, "--out", "emails.jsonl", "--json-stats"}
+			stg, input = pipeline.ConvertStage{}, convertInput
 		case stageClean:
-			args = []string{pipelineScript, "clean", "emails.jsonl", "--out", "cleaned_emails.json", "--json-stats"}
-			if sender != "" {
-				args = append(args, "--sender", sender)
-			}
+			stg = pipeline.CleanStage{}
 		case stageCurate:
-			args = []string{pipelineScript, "curate", "cleaned_emails.json", "--out", "style_shortlist.csv", "--json-stats"}
+			stg = pipeline.CurateStage{}
 		}
+		args := append([]string{pipelineScript}, stg.Args(workDir, sender, input)...)
 
-		cmd := exec.Command(python, args...)
+		cmd := exec.CommandContext(ctx, python, args...)
 		cmd.Dir = workDir
+		setpgid(cmd)
+		cmd.Cancel = func() error { return nil } // we drive SIGTERM/SIGKILL ourselves, see waitWithGracefulCancel
 
 		// Create pipes for stdout and stderr
 		stdout, err := cmd.StdoutPipe()
@@ -992,6 +1743,14 @@ func runPipelineStage(inputFile, sender, workDir string, s stage) tea.Cmd {
 		// Read stdout and stderr concurrently
 		done := make(chan bool, 2)
 
+		// stdout carries the NDJSON progress protocol (falling back to plain
+		// log lines for stages that haven't been ported to it yet); stderr
+		// stays raw since it's only used for failure diagnostics.
+		go func() {
+			streamEvents(workDir, s, io.TeeReader(stdout, &allOutput))
+			done <- true
+		}()
+
 		readPipe := func(pipe io.Reader, isStderr bool) {
 			scanner := bufio.NewScanner(pipe)
 			for scanner.Scan() {
@@ -999,28 +1758,35 @@ func runPipelineStage(inputFile, sender, workDir string, s stage) tea.Cmd {
 				allOutput.WriteString(line + "\n")
 				if isStderr {
 					lastErr = line
-				}
-				// Send log update to UI (truncate long lines)
-				displayLine := line
-				if len(displayLine) > 50 {
-					displayLine = displayLine[:47] + "..."
-				}
-				if program != nil {
-					program.Send(logUpdateMsg{line: displayLine})
+					appendStageLog(workDir, s, "ERROR: "+line)
 				}
 			}
 			done <- true
 		}
 
-		go readPipe(stdout, false)
 		go readPipe(stderr, true)
 
+		// Wait for the command to finish, escalating to a graceful kill of
+		// its whole process group if ctx is cancelled, concurrently with
+		// draining the pipes above: a killed process's pipes only reach EOF
+		// once the kill actually lands, so waiting for cmd.Wait() to return
+		// before the pipe reads would mean a cancelled stage is never
+		// actually killed - it would just sit here blocked on <-done.
+		waitDone := make(chan error, 1)
+		go func() { waitDone <- waitWithGracefulCancel(ctx, cmd) }()
+
 		// Wait for both readers
 		<-done
 		<-done
 
-		// Wait for command to finish
-		err = cmd.Wait()
+		err = <-waitDone
+		if ctx.Err() != nil {
+			// Cancelled mid-stage: the output file is truncated/half-written,
+			// so remove it rather than let a later resume or cache hit treat
+			// it as complete.
+			os.Remove(filepath.Join(workDir, stageOutputName(s)))
+			return stageErrorMsg{stage: s, err: fmt.Errorf("cancelled")}
+		}
 		if err != nil {
 			// Log full error details for debugging
 			logFile := filepath.Join(getCacheDir(), "error.log")
@@ -1049,22 +1815,10 @@ func runPipelineStage(inputFile, sender, workDir string, s stage) tea.Cmd {
 			return stageErrorMsg{stage: s, err: fmt.Errorf("%s", errMsg)}
 		}
 
-		// Parse JSON stats from output
-		var stats map[string]int
-		for _, line := range strings.Split(allOutput.String(), "\n") {
-			if strings.HasPrefix(line, "{") {
-				if err := json.Unmarshal([]byte(line), &stats); err == nil {
-					break
-				}
-			}
-		}
+		stats, _ := stg.ParseStats([]byte(allOutput.String()))
 
 		if s == stageCurate {
-			// Copy to desktop
-			home, _ := os.UserHomeDir()
-			desktop := filepath.Join(home, "Desktop", "style_shortlist.csv")
-			src := filepath.Join(workDir, "style_shortlist.csv")
-			copyFile(src, desktop)
+			stg.OnComplete(workDir) // copies style_shortlist.csv to the Desktop
 
 			// Return all results
 			results := make(map[string]map[string]int)
@@ -1076,6 +1830,435 @@ func runPipelineStage(inputFile, sender, workDir string, s stage) tea.Cmd {
 	}
 }
 
+// shardStageName returns the pipeline.py subcommand for a stage.
+func shardStageName(s stage) string {
+	switch s {
+	case stageClean:
+		return "clean"
+	case stageCurate:
+		return "curate"
+	}
+	return ""
+}
+
+// shardEmailsFile splits a JSONL file into `workers` roughly-equal shard
+// files under workDir/shards/, one per worker, and returns their paths in
+// order. Splitting on line boundaries keeps each shard a valid JSONL file.
+func shardEmailsFile(path string, workers int) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) == 1 && lines[0] == "" {
+		lines = nil
+	}
+
+	shardDir := filepath.Join(filepath.Dir(path), "shards")
+	if err := os.MkdirAll(shardDir, 0755); err != nil {
+		return nil, err
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(lines) && len(lines) > 0 {
+		workers = len(lines)
+	}
+
+	perShard := (len(lines) + workers - 1) / workers
+	if perShard < 1 {
+		perShard = 1
+	}
+
+	var shardPaths []string
+	for i := 0; i < workers; i++ {
+		start := i * perShard
+		if start >= len(lines) {
+			break
+		}
+		end := start + perShard
+		if end > len(lines) {
+			end = len(lines)
+		}
+		shardPath := filepath.Join(shardDir, fmt.Sprintf("shard-%02d.jsonl", i))
+		content := strings.Join(lines[start:end], "\n") + "\n"
+		if err := os.WriteFile(shardPath, []byte(content), 0644); err != nil {
+			return nil, err
+		}
+		shardPaths = append(shardPaths, shardPath)
+	}
+	return shardPaths, nil
+}
+
+// runPipelineStageConcurrent fans a shardable stage out across a pool of
+// Python workers, one per shard, and multiplexes their output into
+// shardProgressMsg updates. It cancels all in-flight workers and writes a
+// partial checkpoint to workDir when cancelChannel is closed.
+func runPipelineStageConcurrent(inputFile, sender, workDir string, s stage, workers int, cancelChannel chan struct{}) tea.Cmd {
+	return func() tea.Msg {
+		if workers < 1 {
+			workers = defaultWorkers
+		}
+
+		stageInput := "emails.jsonl"
+		if s == stageCurate {
+			stageInput = "cleaned_emails.json"
+		}
+		shardPaths, err := shardEmailsFile(filepath.Join(workDir, stageInput), workers)
+		if err != nil {
+			return stageErrorMsg{stage: s, err: fmt.Errorf("failed to shard %s: %w", stageInput, err)}
+		}
+
+		python := getVenvPython()
+		scriptDir := getScriptDir()
+		pipelineScript := filepath.Join(scriptDir, "pipeline.py")
+		subcommand := shardStageName(s)
+
+		// Derived once and shared by every shard, same as the single-process
+		// path: an OS-level interrupt (via rootCtx) or the user cancelling
+		// (via cancelChannel) should tear down every in-flight worker.
+		ctx := ctxFromCancelChannel(rootCtx, cancelChannel)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		merged := map[string]int{}
+		shardTotals := make(map[string][2]int) // shardID -> [done, total], for the aggregate progress bar
+		var firstErr error
+		var cancelled int32 // set via atomic.StoreInt32 from shard goroutines, which can race one another
+
+		for i, shardPath := range shardPaths {
+			shardID := fmt.Sprintf("shard-%02d", i)
+			wg.Add(1)
+			go func(shardID, shardPath string) {
+				defer wg.Done()
+
+				outPath := filepath.Join(workDir, "shards", shardID+".out.jsonl")
+				args := []string{pipelineScript, subcommand, shardPath, "--out", outPath, "--json-stats"}
+				if s == stageClean && sender != "" {
+					args = append(args, "--sender", sender)
+				}
+
+				cmd := exec.CommandContext(ctx, python, args...)
+				cmd.Dir = workDir
+				setpgid(cmd)
+				cmd.Cancel = func() error { return nil } // we drive SIGTERM/SIGKILL ourselves, see waitWithGracefulCancel
+
+				stdout, perr := cmd.StdoutPipe()
+				if perr != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = perr
+					}
+					mu.Unlock()
+					return
+				}
+				if serr := cmd.Start(); serr != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = serr
+					}
+					mu.Unlock()
+					return
+				}
+
+				done := make(chan struct{})
+				go func() {
+					scanner := bufio.NewScanner(stdout)
+					var stats map[string]int
+					for scanner.Scan() {
+						line := scanner.Text()
+						if line == "" {
+							continue
+						}
+
+						ev, ok := parseEvent(line)
+						if !ok {
+							// Not an event: either a stray log line or the
+							// final stats object pipeline.py prints before
+							// exiting.
+							if strings.HasPrefix(line, "{") {
+								var lineStats map[string]int
+								if json.Unmarshal([]byte(line), &lineStats) == nil {
+									stats = lineStats
+								}
+							}
+							appendStageLog(workDir, s, "["+shardID+"] "+line)
+							continue
+						}
+
+						switch ev.Type {
+						case eventTypeProgress:
+							mu.Lock()
+							shardTotals[shardID] = [2]int{ev.Done, ev.Total}
+							doneSum, totalSum := 0, 0
+							for _, dt := range shardTotals {
+								doneSum += dt[0]
+								totalSum += dt[1]
+							}
+							mu.Unlock()
+							if program != nil {
+								program.Send(shardProgressMsg{shardID: shardID, status: shardStatus{
+									downloaded: ev.Done,
+									processed:  ev.Done,
+									total:      ev.Total,
+								}})
+								program.Send(progressEventMsg{stage: ev.Stage, done: doneSum, total: totalSum, phase: ev.Phase})
+							}
+						case eventTypeStat, eventTypeStats:
+							if program != nil {
+								program.Send(statEventMsg{key: ev.Key, value: ev.Value})
+							}
+						case eventTypeLog:
+							appendStageLog(workDir, s, "["+shardID+"] "+ev.Msg)
+							if program != nil {
+								program.Send(logEventMsg{level: ev.Level, msg: ev.Msg})
+							}
+						case eventTypePIIHit:
+							if program != nil {
+								program.Send(piiHitEventMsg{entity: ev.Entity})
+							}
+						}
+					}
+					if stats != nil {
+						mu.Lock()
+						for k, v := range stats {
+							merged[k] += v
+						}
+						mu.Unlock()
+					}
+					close(done)
+				}()
+
+				// Drive the wait/kill concurrently with draining stdout above,
+				// same as the single-process path: a killed worker's stdout
+				// only reaches EOF once the kill actually lands. killGroup
+				// (via waitWithGracefulCancel) signals the whole process
+				// group, not just cmd.Process, so a worker that has spawned
+				// its own children doesn't leave them orphaned.
+				waitDone := make(chan error, 1)
+				go func() { waitDone <- waitWithGracefulCancel(ctx, cmd) }()
+
+				<-done
+				werr := <-waitDone
+
+				if ctx.Err() != nil {
+					atomic.StoreInt32(&cancelled, 1)
+					return
+				}
+				if werr != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = fmt.Errorf("%s: %w", shardID, werr)
+					}
+					mu.Unlock()
+					if program != nil {
+						program.Send(shardProgressMsg{shardID: shardID, status: shardStatus{err: werr.Error()}})
+					}
+					return
+				}
+
+				if program != nil {
+					program.Send(shardProgressMsg{shardID: shardID, status: shardStatus{done: true}})
+				}
+			}(shardID, shardPath)
+		}
+
+		wg.Wait()
+
+		if atomic.LoadInt32(&cancelled) == 1 {
+			writeCheckpoint(workDir, inputFile, sender, s, merged, "cancelled")
+			return stageErrorMsg{stage: s, err: fmt.Errorf("cancelled")}
+		}
+
+		if firstErr != nil {
+			return stageErrorMsg{stage: s, err: firstErr}
+		}
+
+		if err := mergeShardOutputs(workDir, s, shardPaths); err != nil {
+			return stageErrorMsg{stage: s, err: err}
+		}
+
+		if s == stageClean {
+			dedupStats, derr := runDedupPass(python, pipelineScript, workDir)
+			if derr != nil {
+				return stageErrorMsg{stage: s, err: derr}
+			}
+			for k, v := range dedupStats {
+				merged[k] = v
+			}
+		}
+
+		if s == stageCurate {
+			pipeline.CurateStage{}.OnComplete(workDir)
+
+			results := make(map[string]map[string]int)
+			results["curate"] = merged
+			return pipelineCompleteMsg{results: results}
+		}
+
+		return stageCompleteMsg{stage: s, stats: merged}
+	}
+}
+
+// mergeShardOutputs concatenates each worker's shard output into the single
+// intermediate file the next stage expects, then cleans up the shard dir.
+func mergeShardOutputs(workDir string, s stage, shardPaths []string) error {
+	outName := "cleaned_emails.json"
+	if s == stageCurate {
+		outName = "style_shortlist.csv"
+	}
+
+	var merged strings.Builder
+	headerWritten := false
+	for i := range shardPaths {
+		shardID := fmt.Sprintf("shard-%02d", i)
+		outPath := filepath.Join(workDir, "shards", shardID+".out.jsonl")
+		data, err := os.ReadFile(outPath)
+		if err != nil {
+			continue
+		}
+
+		if s != stageCurate {
+			merged.Write(data)
+			continue
+		}
+
+		// Curate's shard output is a CSV, not JSONL: every shard carries its
+		// own header row, so a plain concatenation would repeat it once per
+		// shard. Keep only the first shard's header and append every
+		// shard's data rows.
+		lines := strings.SplitAfter(string(data), "\n")
+		if len(lines) == 0 {
+			continue
+		}
+		if !headerWritten {
+			merged.WriteString(lines[0])
+			headerWritten = true
+		}
+		for _, line := range lines[1:] {
+			merged.WriteString(line)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(workDir, outName), []byte(merged.String()), 0644); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(workDir, "shards"))
+}
+
+// runDedupPass drops near-duplicate drafts/replies from the merged
+// cleaned_emails.json using MinHash/LSH (128 permutations, threshold 0.85)
+// before curation. It runs once on the merged file rather than per-shard
+// since the LSH index needs the full set to catch duplicates that landed
+// in different shards.
+func runDedupPass(python, pipelineScript, workDir string) (map[string]int, error) {
+	args := []string{pipelineScript, "dedup", "cleaned_emails.json", "--out", "cleaned_emails.json", "--threshold", "0.85", "--json-stats"}
+	cmd := exec.Command(python, args...)
+	cmd.Dir = workDir
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("dedup pass: %w", err)
+	}
+
+	var stats map[string]int
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, "{") {
+			if json.Unmarshal([]byte(line), &stats) == nil {
+				break
+			}
+		}
+	}
+	return stats, nil
+}
+
+// checkpoint is what writeCheckpoint persists to workDir/checkpoint.json
+// after each completed (or cancelled) stage, so a later run in the same
+// workDir can offer to resume instead of starting over.
+type checkpoint struct {
+	Stage     int            `json:"stage"`
+	Status    string         `json:"status"`
+	InputHash string         `json:"input_hash,omitempty"`
+	Sender    string         `json:"sender,omitempty"`
+	Stats     map[string]int `json:"stats"`
+	Timestamp string         `json:"timestamp"`
+}
+
+// writeCheckpoint records stage s with its stats and status ("completed", or
+// "cancelled" for a sharded stage the user backed out of) together with the
+// current hash of inputFile. A resume redoes the recorded stage in full;
+// partial shard results are not reused.
+func writeCheckpoint(workDir, inputFile, sender string, s stage, stats map[string]int, status string) {
+	inputHash, _ := sha256File(inputFile)
+	cp := checkpoint{
+		Stage:     int(s),
+		Status:    status,
+		InputHash: inputHash,
+		Sender:    sender,
+		Stats:     stats,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return
+	}
+	os.WriteFile(filepath.Join(workDir, "checkpoint.json"), data, 0644)
+}
+
+// loadCheckpoint reads workDir/checkpoint.json, if one exists.
+func loadCheckpoint(workDir string) (checkpoint, bool) {
+	data, err := os.ReadFile(filepath.Join(workDir, "checkpoint.json"))
+	if err != nil {
+		return checkpoint{}, false
+	}
+	var cp checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return checkpoint{}, false
+	}
+	return cp, true
+}
+
+// checkpointMatches reports whether workDir has a checkpoint recorded
+// against inputFile's current contents. A mismatch means the file at
+// inputFile has changed (or is a different file entirely) since the
+// checkpoint was written, so any leftover intermediates can't be trusted.
+func checkpointMatches(workDir, inputFile string) bool {
+	cp, ok := loadCheckpoint(workDir)
+	if !ok || cp.InputHash == "" {
+		return false
+	}
+	hash, err := sha256File(inputFile)
+	if err != nil {
+		return false
+	}
+	return hash == cp.InputHash
+}
+
+// wipeIntermediates removes every stage's intermediate output from workDir,
+// used when a stale checkpoint is discarded so the next run can't
+// accidentally pick up an artifact from an unrelated input file.
+func wipeIntermediates(workDir string) {
+	for s := stageImport; s < stageDone; s++ {
+		os.Remove(filepath.Join(workDir, stageOutputName(s)))
+	}
+	os.Remove(filepath.Join(workDir, "checkpoint.json"))
+}
+
+// stageFromName maps a --from flag value to a stage, for explicit resume.
+func stageFromName(name string) (stage, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "import":
+		return stageImport, true
+	case "convert":
+		return stageConvert, true
+	case "clean":
+		return stageClean, true
+	case "curate":
+		return stageCurate, true
+	}
+	return stageImport, false
+}
+
 func copyFile(src, dst string) error {
 	input, err := os.ReadFile(src)
 	if err != nil {
@@ -1084,8 +2267,120 @@ func copyFile(src, dst string) error {
 	return os.WriteFile(dst, input, 0644)
 }
 
+// scoreShortlist runs every row of style_shortlist.csv through the
+// configured LLM backend and appends llm_score/llm_rationale columns,
+// rewriting the CSV (and the copy on the Desktop) in place.
+func scoreShortlist(workDir string, cfg scorer.Config) tea.Cmd {
+	return func() tea.Msg {
+		s, err := scorer.New(cfg)
+		if err != nil {
+			return llmScoreErrorMsg{err: err}
+		}
+
+		path := filepath.Join(workDir, "style_shortlist.csv")
+		f, err := os.Open(path)
+		if err != nil {
+			return llmScoreErrorMsg{err: err}
+		}
+		reader := csv.NewReader(f)
+		rows, err := reader.ReadAll()
+		f.Close()
+		if err != nil {
+			return llmScoreErrorMsg{err: err}
+		}
+		if len(rows) == 0 {
+			return llmScoreCompleteMsg{scored: 0}
+		}
+
+		header := rows[0]
+		bodyCol := len(header) - 1
+		for i, col := range header {
+			if strings.EqualFold(col, "body") {
+				bodyCol = i
+				break
+			}
+		}
+
+		ctx := context.Background()
+		newHeader := append(append([]string{}, header...), "llm_score", "llm_rationale")
+		out := [][]string{newHeader}
+
+		scored := 0
+		for _, row := range rows[1:] {
+			var email string
+			if bodyCol < len(row) {
+				email = row[bodyCol]
+			}
+			score, rationale, err := s.Score(ctx, email)
+			scoreStr, rationaleStr := "", ""
+			if err != nil {
+				rationaleStr = "scoring failed: " + err.Error()
+			} else {
+				scoreStr = strconv.FormatFloat(score, 'f', 2, 64)
+				rationaleStr = rationale
+				scored++
+			}
+			out = append(out, append(append([]string{}, row...), scoreStr, rationaleStr))
+		}
+
+		if err := writeCSV(path, out); err != nil {
+			return llmScoreErrorMsg{err: err}
+		}
+		home, _ := os.UserHomeDir()
+		writeCSV(filepath.Join(home, "Desktop", "style_shortlist.csv"), out)
+
+		return llmScoreCompleteMsg{scored: scored}
+	}
+}
+
+func writeCSV(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	defer w.Flush()
+	return w.WriteAll(rows)
+}
+
+// resolveWorkers picks the worker pool size for the Clean/Curate stages:
+// --workers flag wins, then VOICE_SYNTH_WORKERS, then defaultWorkers.
+func resolveWorkers() int {
+	workersFlag := flag.Int("workers", 0, "number of parallel Python workers for the clean/curate stages")
+	forceFlag := flag.Bool("force", false, "ignore any existing checkpoint.json and start the pipeline fresh")
+	fromFlag := flag.String("from", "", "resume explicitly from this stage (import, convert, clean, curate), skipping the checkpoint prompt")
+	notify := flag.Bool("notify", false, "email a completion summary with the shortlist attached; see {UserConfigDir}/voice-synth/mailsettings")
+	watch := flag.Bool("watch", false, "after the first run, watch the input file and re-run the pipeline whenever it changes")
+	flag.Parse()
+
+	forceFresh = *forceFlag
+	resumeFromFlag = *fromFlag
+	notifyFlag = *notify
+	watchFlag = *watch
+
+	if *workersFlag > 0 {
+		return *workersFlag
+	}
+	if v := os.Getenv("VOICE_SYNTH_WORKERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultWorkers
+}
+
 func main() {
-	program = tea.NewProgram(initialModel(), tea.WithAltScreen())
+	rootCtx, cancelRoot = context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		cancelRoot()
+	}()
+
+	workers := resolveWorkers()
+	program = tea.NewProgram(initialModel(workers), tea.WithAltScreen())
 	if _, err := program.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
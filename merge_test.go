@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeShard(t *testing.T, workDir, shardID, content string) {
+	t.Helper()
+	dir := filepath.Join(workDir, "shards")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, shardID+".out.jsonl"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMergeShardOutputsCurateDedupesHeader(t *testing.T) {
+	workDir := t.TempDir()
+	writeShard(t, workDir, "shard-00", "subject,score\nhello,1\nworld,2\n")
+	writeShard(t, workDir, "shard-01", "subject,score\nfoo,3\n")
+
+	if err := mergeShardOutputs(workDir, stageCurate, make([]string, 2)); err != nil {
+		t.Fatalf("mergeShardOutputs: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(workDir, "style_shortlist.csv"))
+	if err != nil {
+		t.Fatalf("reading merged output: %v", err)
+	}
+
+	want := "subject,score\nhello,1\nworld,2\nfoo,3\n"
+	if string(got) != want {
+		t.Fatalf("merged csv = %q, want %q", got, want)
+	}
+}
+
+func TestMergeShardOutputsCleanConcatenatesRaw(t *testing.T) {
+	workDir := t.TempDir()
+	writeShard(t, workDir, "shard-00", `{"id":1}`+"\n")
+	writeShard(t, workDir, "shard-01", `{"id":2}`+"\n")
+
+	if err := mergeShardOutputs(workDir, stageClean, make([]string, 2)); err != nil {
+		t.Fatalf("mergeShardOutputs: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(workDir, "cleaned_emails.json"))
+	if err != nil {
+		t.Fatalf("reading merged output: %v", err)
+	}
+
+	want := `{"id":1}` + "\n" + `{"id":2}` + "\n"
+	if string(got) != want {
+		t.Fatalf("merged jsonl = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// notifyFlag (--notify) turns on the completion email even without
+// notify = true set in config.toml, for headless/CI runs.
+var notifyFlag bool
+
+// mailSettings is the bookpipeline-style mailsettings format: one line of
+// "smtpserver port username password from to", space-separated.
+type mailSettings struct {
+	server   string
+	port     string
+	username string
+	password string
+	from     string
+	to       string
+}
+
+// loadMailSettings reads {UserConfigDir}/voice-synth/mailsettings.
+func loadMailSettings() (mailSettings, bool) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return mailSettings{}, false
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "voice-synth", "mailsettings"))
+	if err != nil {
+		return mailSettings{}, false
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 6 {
+		return mailSettings{}, false
+	}
+	return mailSettings{
+		server:   fields[0],
+		port:     fields[1],
+		username: fields[2],
+		password: fields[3],
+		from:     fields[4],
+		to:       fields[5],
+	}, true
+}
+
+// notifyEnabled reports whether a completion email should be sent: either
+// --notify was passed, or notify = true is set at the top level of
+// config.toml, alongside the [timeouts] table.
+func notifyEnabled() bool {
+	return notifyFlag || notifyConfigKeySet()
+}
+
+func notifyConfigKeySet() bool {
+	f, err := os.Open(filepath.Join(getConfigDir(), "config.toml"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	inTable := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			inTable = true
+			continue
+		}
+		if inTable {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if ok && strings.TrimSpace(key) == "notify" {
+			return strings.TrimSpace(value) == "true"
+		}
+	}
+	return false
+}
+
+// sendCompletionEmailCmd emails a per-stage stats summary with
+// style_shortlist.csv attached. Failures are logged to error.log rather than
+// surfaced as a stage error - a broken mail server shouldn't make an
+// otherwise-successful run look like it failed.
+func sendCompletionEmailCmd(workDir string, stageStats map[stage]map[string]int, sender string) tea.Cmd {
+	return func() tea.Msg {
+		settings, ok := loadMailSettings()
+		if !ok {
+			return nil
+		}
+		if err := sendCompletionEmail(workDir, stageStats, sender, settings); err != nil {
+			logFile := filepath.Join(getCacheDir(), "error.log")
+			os.WriteFile(logFile, []byte(fmt.Sprintf("Notification email failed: %v\n", err)), 0644)
+		}
+		return nil
+	}
+}
+
+func sendCompletionEmail(workDir string, stageStats map[stage]map[string]int, sender string, settings mailSettings) error {
+	attachment := filepath.Join(workDir, "style_shortlist.csv")
+	csvData, err := os.ReadFile(attachment)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", attachment, err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	summary, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/plain; charset=utf-8"}})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(summary, "Voice Synthesizer pipeline complete.\n\n")
+	fmt.Fprintf(summary, "Sender detected: %s\n\n", sender)
+	fmt.Fprintf(summary, "Stage      Rows in  Rows out\n")
+	for s := stageImport; s < stageDone; s++ {
+		stats, ok := stageStats[s]
+		if !ok {
+			continue
+		}
+		in, out := stats["total"], stats["kept"]
+		fmt.Fprintf(summary, "%-10s %7d  %7d\n", stageCacheName(s), in, out)
+	}
+
+	attachmentPart, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/csv"},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {`attachment; filename="style_shortlist.csv"`},
+	})
+	if err != nil {
+		return err
+	}
+	enc := base64.NewEncoder(base64.StdEncoding, attachmentPart)
+	if _, err := enc.Write(csvData); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+
+	if err := mw.Close(); err != nil {
+		return err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", settings.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", settings.to)
+	fmt.Fprintf(&msg, "Subject: Voice Synthesizer: pipeline complete\r\n")
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/mixed; boundary=%s\r\n", mw.Boundary())
+	fmt.Fprintf(&msg, "\r\n")
+	msg.Write(body.Bytes())
+
+	return deliverSMTP(settings, msg.Bytes())
+}
+
+// deliverSMTP sends msg over STARTTLS, authenticating with PLAIN auth.
+func deliverSMTP(settings mailSettings, msg []byte) error {
+	addr := settings.server + ":" + settings.port
+	c, err := smtp.Dial(addr)
+	if err != nil {
+		return fmt.Errorf("dial %s: %w", addr, err)
+	}
+	defer c.Close()
+
+	if err := c.StartTLS(&tls.Config{ServerName: settings.server}); err != nil {
+		return fmt.Errorf("starttls: %w", err)
+	}
+
+	auth := smtp.PlainAuth("", settings.username, settings.password, settings.server)
+	if err := c.Auth(auth); err != nil {
+		return fmt.Errorf("auth: %w", err)
+	}
+
+	if err := c.Mail(settings.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(settings.to); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
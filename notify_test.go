@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMailSettings(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	if err := os.MkdirAll(filepath.Join(dir, "voice-synth"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	body := "smtp.example.com 587 user@example.com hunter2 from@example.com to@example.com"
+	if err := os.WriteFile(filepath.Join(dir, "voice-synth", "mailsettings"), []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := loadMailSettings()
+	if !ok {
+		t.Fatal("expected loadMailSettings to succeed")
+	}
+	want := mailSettings{
+		server:   "smtp.example.com",
+		port:     "587",
+		username: "user@example.com",
+		password: "hunter2",
+		from:     "from@example.com",
+		to:       "to@example.com",
+	}
+	if got != want {
+		t.Fatalf("loadMailSettings() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoadMailSettingsWrongFieldCount(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	if err := os.MkdirAll(filepath.Join(dir, "voice-synth"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "voice-synth", "mailsettings"), []byte("not enough fields"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := loadMailSettings(); ok {
+		t.Fatal("expected loadMailSettings to fail on a malformed file")
+	}
+}
+
+func TestLoadMailSettingsMissingFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if _, ok := loadMailSettings(); ok {
+		t.Fatal("expected loadMailSettings to fail when no mailsettings file exists")
+	}
+}
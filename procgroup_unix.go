@@ -0,0 +1,24 @@
+//go:build !windows
+
+package main
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setpgid puts cmd in its own process group so killGroup can signal the
+// whole tree (pipeline.py plus anything it shells out to) at once, instead
+// of just the immediate child.
+func setpgid(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killGroup signals cmd's process group. Safe to call after the process has
+// already exited; the kill just fails silently.
+func killGroup(cmd *exec.Cmd, sig syscall.Signal) {
+	if cmd.Process == nil {
+		return
+	}
+	syscall.Kill(-cmd.Process.Pid, sig)
+}
@@ -0,0 +1,20 @@
+//go:build windows
+
+package main
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setpgid is a no-op on Windows, which has no POSIX process groups.
+func setpgid(cmd *exec.Cmd) {}
+
+// killGroup has no graceful-signal equivalent on Windows, so it just hard-
+// kills the process directly regardless of sig.
+func killGroup(cmd *exec.Cmd, sig syscall.Signal) {
+	if cmd.Process == nil {
+		return
+	}
+	cmd.Process.Kill()
+}
@@ -0,0 +1,103 @@
+package scorer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const anthropicBaseURL = "https://api.anthropic.com/v1/messages"
+const anthropicVersion = "2023-06-01"
+
+type anthropicScorer struct {
+	apiKey         string
+	model          string
+	promptTemplate string
+	client         *http.Client
+}
+
+func newAnthropicScorer(cfg Config) Scorer {
+	model := cfg.Model
+	if model == "" {
+		model = "claude-3-5-haiku-latest"
+	}
+	return &anthropicScorer{
+		apiKey:         cfg.APIKey,
+		model:          model,
+		promptTemplate: cfg.PromptTemplate,
+		client:         &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+type anthropicMessagesRequest struct {
+	Model     string             `json:"model"`
+	MaxTokens int                `json:"max_tokens"`
+	Messages  []anthropicMessage `json:"messages"`
+}
+
+type anthropicMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type anthropicMessagesResponse struct {
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *anthropicScorer) Score(ctx context.Context, email string) (float64, string, error) {
+	prompt := strings.ReplaceAll(s.promptTemplate, "{{email}}", email)
+	reqBody, err := json.Marshal(anthropicMessagesRequest{
+		Model:     s.model,
+		MaxTokens: 256,
+		Messages:  []anthropicMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, anthropicBaseURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", s.apiKey)
+	req.Header.Set("anthropic-version", anthropicVersion)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", retryable(fmt.Errorf("anthropic: %w", err))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, "", retryable(fmt.Errorf("anthropic: reading response: %w", err))
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return 0, "", retryable(fmt.Errorf("anthropic: status %d: %s", resp.StatusCode, string(data)))
+	}
+
+	var msgResp anthropicMessagesResponse
+	if err := json.Unmarshal(data, &msgResp); err != nil {
+		return 0, "", fmt.Errorf("anthropic: decoding response: %w", err)
+	}
+	if msgResp.Error != nil {
+		return 0, "", fmt.Errorf("anthropic: %s", msgResp.Error.Message)
+	}
+	if len(msgResp.Content) == 0 {
+		return 0, "", fmt.Errorf("anthropic: empty content in response")
+	}
+
+	return parseScoreAndRationale(msgResp.Content[0].Text)
+}
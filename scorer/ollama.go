@@ -0,0 +1,113 @@
+package scorer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultOllamaBaseURL = "http://localhost:11434"
+
+type ollamaScorer struct {
+	baseURL        string
+	model          string
+	promptTemplate string
+	client         *http.Client
+}
+
+func newOllamaScorer(cfg Config) Scorer {
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultOllamaBaseURL
+	}
+	model := cfg.Model
+	if model == "" {
+		model = "llama3"
+	}
+	return &ollamaScorer{
+		baseURL:        baseURL,
+		model:          model,
+		promptTemplate: cfg.PromptTemplate,
+		client:         &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+type ollamaGenerateRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaGenerateChunk struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+func (s *ollamaScorer) Score(ctx context.Context, email string) (float64, string, error) {
+	prompt := strings.ReplaceAll(s.promptTemplate, "{{email}}", email)
+	body, err := json.Marshal(ollamaGenerateRequest{Model: s.model, Prompt: prompt, Stream: true})
+	if err != nil {
+		return 0, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/api/generate", bytes.NewReader(body))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", retryable(fmt.Errorf("ollama: %w", err))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return 0, "", retryable(fmt.Errorf("ollama: server error %d", resp.StatusCode))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, "", fmt.Errorf("ollama: unexpected status %d", resp.StatusCode)
+	}
+
+	// Ollama streams one JSON object per line; assemble the full response
+	// token by token as it arrives.
+	var full strings.Builder
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var chunk ollamaGenerateChunk
+		if err := json.Unmarshal(scanner.Bytes(), &chunk); err != nil {
+			continue
+		}
+		full.WriteString(chunk.Response)
+		if chunk.Done {
+			break
+		}
+	}
+
+	return parseScoreAndRationale(full.String())
+}
+
+// parseScoreAndRationale expects "0.82 rationale text..." and is shared by
+// all three providers since the prompt format is identical.
+func parseScoreAndRationale(text string) (float64, string, error) {
+	text = strings.TrimSpace(text)
+	parts := strings.SplitN(text, " ", 2)
+	if len(parts) == 0 {
+		return 0, "", fmt.Errorf("scorer: empty response")
+	}
+	score, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("scorer: could not parse score from %q: %w", text, err)
+	}
+	rationale := ""
+	if len(parts) > 1 {
+		rationale = strings.TrimSpace(parts[1])
+	}
+	return score, rationale, nil
+}
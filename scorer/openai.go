@@ -0,0 +1,99 @@
+package scorer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const openAIBaseURL = "https://api.openai.com/v1/chat/completions"
+
+type openAIScorer struct {
+	apiKey         string
+	model          string
+	promptTemplate string
+	client         *http.Client
+}
+
+func newOpenAIScorer(cfg Config) Scorer {
+	model := cfg.Model
+	if model == "" {
+		model = "gpt-4o-mini"
+	}
+	return &openAIScorer{
+		apiKey:         cfg.APIKey,
+		model:          model,
+		promptTemplate: cfg.PromptTemplate,
+		client:         &http.Client{Timeout: 60 * time.Second},
+	}
+}
+
+type openAIChatRequest struct {
+	Model    string              `json:"model"`
+	Messages []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message openAIChatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (s *openAIScorer) Score(ctx context.Context, email string) (float64, string, error) {
+	prompt := strings.ReplaceAll(s.promptTemplate, "{{email}}", email)
+	reqBody, err := json.Marshal(openAIChatRequest{
+		Model:    s.model,
+		Messages: []openAIChatMessage{{Role: "user", Content: prompt}},
+	})
+	if err != nil {
+		return 0, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, openAIBaseURL, bytes.NewReader(reqBody))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, "", retryable(fmt.Errorf("openai: %w", err))
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, "", retryable(fmt.Errorf("openai: reading response: %w", err))
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return 0, "", retryable(fmt.Errorf("openai: status %d: %s", resp.StatusCode, string(data)))
+	}
+
+	var chatResp openAIChatResponse
+	if err := json.Unmarshal(data, &chatResp); err != nil {
+		return 0, "", fmt.Errorf("openai: decoding response: %w", err)
+	}
+	if chatResp.Error != nil {
+		return 0, "", fmt.Errorf("openai: %s", chatResp.Error.Message)
+	}
+	if len(chatResp.Choices) == 0 {
+		return 0, "", fmt.Errorf("openai: no choices in response")
+	}
+
+	return parseScoreAndRationale(chatResp.Choices[0].Message.Content)
+}
@@ -0,0 +1,196 @@
+// Package scorer provides pluggable LLM backends for scoring candidate
+// emails during curation. Each Provider implements Scorer; New picks the
+// right one from a Config built by the TUI's model-picker screen.
+package scorer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// Scorer rates how well an email represents the user's writing voice.
+// rationale is a short human-readable explanation suitable for a CSV column.
+type Scorer interface {
+	Score(ctx context.Context, email string) (score float64, rationale string, err error)
+}
+
+// Provider identifies an LLM backend.
+type Provider string
+
+const (
+	ProviderOllama    Provider = "ollama"
+	ProviderOpenAI    Provider = "openai"
+	ProviderAnthropic Provider = "anthropic"
+)
+
+// DefaultPromptTemplate is used when the user hasn't set one via the
+// model-picker screen. {{email}} is substituted with the candidate body.
+const DefaultPromptTemplate = `Rate how well this email reflects a confident, distinctive personal writing voice on a scale from 0.0 to 1.0. Reply with just the number followed by a one-sentence rationale.
+
+Email:
+{{email}}`
+
+// Config configures a Scorer. BaseURL is only used by the Ollama provider
+// (it defaults to http://localhost:11434). APIKey is only used by OpenAI
+// and Anthropic.
+type Config struct {
+	Provider       Provider
+	APIKey         string
+	Model          string
+	BaseURL        string
+	PromptTemplate string
+
+	// RequestsPerMinute bounds outbound call rate; 0 means use the
+	// provider's default.
+	RequestsPerMinute int
+}
+
+// New builds the Scorer for cfg.Provider, wrapped with a rate limiter and
+// retry-with-backoff so callers don't need to think about either.
+func New(cfg Config) (Scorer, error) {
+	if cfg.PromptTemplate == "" {
+		cfg.PromptTemplate = DefaultPromptTemplate
+	}
+
+	var inner Scorer
+	switch cfg.Provider {
+	case ProviderOllama:
+		inner = newOllamaScorer(cfg)
+	case ProviderOpenAI:
+		inner = newOpenAIScorer(cfg)
+	case ProviderAnthropic:
+		inner = newAnthropicScorer(cfg)
+	default:
+		return nil, fmt.Errorf("scorer: unknown provider %q", cfg.Provider)
+	}
+
+	rpm := cfg.RequestsPerMinute
+	if rpm <= 0 {
+		rpm = defaultRequestsPerMinute(cfg.Provider)
+	}
+
+	return &limitedScorer{
+		inner:   inner,
+		limiter: newRateLimiter(rpm),
+	}, nil
+}
+
+func defaultRequestsPerMinute(p Provider) int {
+	switch p {
+	case ProviderOllama:
+		return 600 // local, effectively unbounded
+	case ProviderOpenAI, ProviderAnthropic:
+		return 60
+	}
+	return 30
+}
+
+// rateLimiter is a minimal token-bucket-by-interval limiter: Wait blocks
+// until at least 60s/rpm has elapsed since the last call returned.
+type rateLimiter struct {
+	interval time.Duration
+	last     time.Time
+	ticket   chan struct{}
+}
+
+func newRateLimiter(requestsPerMinute int) *rateLimiter {
+	if requestsPerMinute <= 0 {
+		requestsPerMinute = 1
+	}
+	rl := &rateLimiter{
+		interval: time.Minute / time.Duration(requestsPerMinute),
+		ticket:   make(chan struct{}, 1),
+	}
+	rl.ticket <- struct{}{}
+	return rl
+}
+
+func (rl *rateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-rl.ticket:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { rl.ticket <- struct{}{} }()
+
+	wait := rl.interval - time.Since(rl.last)
+	if wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	rl.last = time.Now()
+	return nil
+}
+
+// limitedScorer wraps a Scorer with rate limiting and retry-with-backoff on
+// transient errors, so individual provider implementations can stay simple.
+type limitedScorer struct {
+	inner   Scorer
+	limiter *rateLimiter
+}
+
+const maxRetries = 4
+
+func (s *limitedScorer) Score(ctx context.Context, email string) (float64, string, error) {
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := backoffDelay(attempt)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return 0, "", ctx.Err()
+			}
+		}
+
+		if err := s.limiter.Wait(ctx); err != nil {
+			return 0, "", err
+		}
+
+		score, rationale, err := s.inner.Score(ctx, email)
+		if err == nil {
+			return score, rationale, nil
+		}
+		lastErr = err
+		if !isRetryable(err) {
+			return 0, "", err
+		}
+	}
+	return 0, "", fmt.Errorf("scorer: giving up after %d attempts: %w", maxRetries+1, lastErr)
+}
+
+// backoffDelay returns an exponential base delay, capped at 30s, plus up to
+// 50% random jitter on top of that base.
+func backoffDelay(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt-1)) * 500 * time.Millisecond
+	if base > 30*time.Second {
+		base = 30 * time.Second
+	}
+	jitter := time.Duration(rand.Int63n(int64(base / 2)))
+	return base + jitter
+}
+
+// retryableError wraps a provider error that's worth retrying (rate limit,
+// timeout, 5xx). Providers return this instead of a bare error when they
+// detect one of those conditions.
+type retryableError struct{ err error }
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+func retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+func isRetryable(err error) bool {
+	_, ok := err.(*retryableError)
+	return ok
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// secretsFile is the fallback store when no OS keychain is available, e.g.
+// on Linux without secret-tool/gnome-keyring. 0600 so only the owner can
+// read the API keys.
+func secretsFile() string {
+	return filepath.Join(getCacheDir(), "secrets.json")
+}
+
+// saveSecret stores an API key for a provider, preferring the OS keychain
+// and falling back to ~/.cache/voice-synth/secrets.json.
+func saveSecret(provider, apiKey string) error {
+	if err := keychainSet(provider, apiKey); err == nil {
+		return nil
+	}
+	secrets := loadSecretsFile()
+	secrets[provider] = apiKey
+	return writeSecretsFile(secrets)
+}
+
+// loadSecret retrieves a previously saved API key for a provider.
+func loadSecret(provider string) (string, error) {
+	if key, err := keychainGet(provider); err == nil && key != "" {
+		return key, nil
+	}
+	secrets := loadSecretsFile()
+	key, ok := secrets[provider]
+	if !ok {
+		return "", fmt.Errorf("no saved API key for %s", provider)
+	}
+	return key, nil
+}
+
+func loadSecretsFile() map[string]string {
+	data, err := os.ReadFile(secretsFile())
+	if err != nil {
+		return map[string]string{}
+	}
+	var secrets map[string]string
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return map[string]string{}
+	}
+	return secrets
+}
+
+func writeSecretsFile(secrets map[string]string) error {
+	data, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(getCacheDir(), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(secretsFile(), data, 0600)
+}
+
+const keychainService = "voice-synth"
+
+// keychainSet/keychainGet shell out to the platform keychain CLI rather than
+// pulling in a cgo keychain binding, matching the rest of this tool's
+// "thin Go wrapper over real CLIs" approach to Python/venv management.
+func keychainSet(account, secret string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		exec.Command("security", "delete-generic-password", "-a", account, "-s", keychainService).Run()
+		return exec.Command("security", "add-generic-password", "-a", account, "-s", keychainService, "-w", secret).Run()
+	case "linux":
+		cmd := exec.Command("secret-tool", "store", "--label", keychainService+" "+account, "service", keychainService, "account", account)
+		cmd.Stdin = strings.NewReader(secret)
+		return cmd.Run()
+	}
+	return fmt.Errorf("keychain: unsupported platform %s", runtime.GOOS)
+}
+
+func keychainGet(account string) (string, error) {
+	switch runtime.GOOS {
+	case "darwin":
+		out, err := exec.Command("security", "find-generic-password", "-a", account, "-s", keychainService, "-w").Output()
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(out)), nil
+	case "linux":
+		out, err := exec.Command("secret-tool", "lookup", "service", keychainService, "account", account).Output()
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	return "", fmt.Errorf("keychain: unsupported platform %s", runtime.GOOS)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// defaultStageTimeouts match the durations in the request: large mailboxes
+// make clean/convert the slowest stages by far.
+var defaultStageTimeouts = map[stage]time.Duration{
+	stageImport:  5 * time.Minute,
+	stageConvert: 30 * time.Minute,
+	stageClean:   60 * time.Minute,
+	stageCurate:  15 * time.Minute,
+}
+
+// getConfigDir mirrors getCacheDir's XDG handling for config.toml.
+func getConfigDir() string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "voice-synth")
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".config", "voice-synth")
+}
+
+// timeoutConfig holds the per-stage timeouts read from config.toml's
+// [timeouts] table, falling back to defaultStageTimeouts for anything unset.
+type timeoutConfig struct {
+	durations map[stage]time.Duration
+}
+
+// stageTimeout returns how long s is allowed to run before dispatchStage
+// kills it.
+func (c timeoutConfig) stageTimeout(s stage) time.Duration {
+	if d, ok := c.durations[s]; ok {
+		return d
+	}
+	return defaultStageTimeouts[s]
+}
+
+// loadTimeoutConfig reads the [timeouts] table out of
+// ~/.config/voice-synth/config.toml. There's no TOML dependency in this
+// project, so this only understands the flat "key = value" shape that
+// table actually needs rather than the full spec.
+func loadTimeoutConfig() timeoutConfig {
+	cfg := timeoutConfig{durations: make(map[stage]time.Duration)}
+
+	f, err := os.Open(filepath.Join(getConfigDir(), "config.toml"))
+	if err != nil {
+		return cfg
+	}
+	defer f.Close()
+
+	inTimeouts := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			inTimeouts = line == "[timeouts]"
+			continue
+		}
+		if !inTimeouts {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		s, ok := stageFromName(key)
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			continue
+		}
+		cfg.durations[s] = d
+	}
+	return cfg
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfigTOML(t *testing.T, body string) {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	if err := os.MkdirAll(filepath.Join(dir, "voice-synth"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "voice-synth", "config.toml"), []byte(body), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadTimeoutConfig(t *testing.T) {
+	writeConfigTOML(t, `
+notify = true
+
+[timeouts]
+clean = "45m"
+curate = "10m"
+bogus = "5m"
+`)
+
+	cfg := loadTimeoutConfig()
+
+	if got := cfg.stageTimeout(stageClean); got != 45*time.Minute {
+		t.Fatalf("clean timeout = %v, want 45m", got)
+	}
+	if got := cfg.stageTimeout(stageCurate); got != 10*time.Minute {
+		t.Fatalf("curate timeout = %v, want 10m", got)
+	}
+	// Unset in the table: falls back to the default.
+	if got := cfg.stageTimeout(stageImport); got != defaultStageTimeouts[stageImport] {
+		t.Fatalf("import timeout = %v, want default %v", got, defaultStageTimeouts[stageImport])
+	}
+}
+
+func TestLoadTimeoutConfigMissingFile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := loadTimeoutConfig()
+	if got := cfg.stageTimeout(stageClean); got != defaultStageTimeouts[stageClean] {
+		t.Fatalf("clean timeout = %v, want default %v", got, defaultStageTimeouts[stageClean])
+	}
+}
+
+func TestLoadTimeoutConfigIgnoresOtherTables(t *testing.T) {
+	writeConfigTOML(t, `
+[other]
+clean = "1m"
+`)
+
+	cfg := loadTimeoutConfig()
+	if got := cfg.stageTimeout(stageClean); got != defaultStageTimeouts[stageClean] {
+		t.Fatalf("clean timeout = %v, want default %v (value from [other] should be ignored)", got, defaultStageTimeouts[stageClean])
+	}
+}
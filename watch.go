@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchFlag (--watch) keeps the TUI alive after the first successful run,
+// re-running the pipeline whenever inputFile changes instead of exiting to
+// screenResults.
+var watchFlag bool
+
+// watchDebounce is the quiet window after the last filesystem event before
+// a burst is collapsed into a single pipeline run.
+const watchDebounce = 2 * time.Second
+
+// startWatcher watches path (and, if path is a directory, its current
+// entries) for WRITE/CREATE/RENAME events and returns a channel that
+// receives one value per debounced burst. The watcher stops when ctx is
+// cancelled.
+func startWatcher(ctx context.Context, path string) (<-chan struct{}, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		entries, _ := os.ReadDir(path)
+		for _, e := range entries {
+			watcher.Add(filepath.Join(path, e.Name()))
+		}
+	}
+	if err := watcher.Add(path); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	out := make(chan struct{}, 1)
+	go func() {
+		defer watcher.Close()
+
+		var timer *time.Timer
+		var debounceCh <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				if timer == nil {
+					timer = time.NewTimer(watchDebounce)
+				} else {
+					if !timer.Stop() {
+						select {
+						case <-timer.C:
+						default:
+						}
+					}
+					timer.Reset(watchDebounce)
+				}
+				debounceCh = timer.C
+
+			case <-debounceCh:
+				debounceCh = nil
+				select {
+				case out <- struct{}{}:
+				default:
+					// A burst is already queued; this one collapses into it.
+				}
+
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// waitForWatchTrigger turns the next value off events into a watchTriggerMsg.
+// Callers re-arm it themselves after each pipeline run finishes, rather than
+// looping internally, so at most one run is ever queued at a time.
+func waitForWatchTrigger(events <-chan struct{}) tea.Cmd {
+	return func() tea.Msg {
+		if _, ok := <-events; !ok {
+			return nil
+		}
+		return watchTriggerMsg{}
+	}
+}
+
+// stopWatching cancels the running watcher, if any, so q/esc/ctrl+c never
+// leave an fsnotify goroutine behind after leaving screenWatching.
+func (m *model) stopWatching() {
+	if m.watchCancel != nil {
+		m.watchCancel()
+		m.watchCancel = nil
+	}
+	m.watchEvents = nil
+}
+
+// formatAgo renders how long ago t was, to the nearest unit, for a display
+// like "3m ago".
+func formatAgo(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	}
+}
+
+func (m model) viewWatching() string {
+	content := titleStyle.Render("Watching for changes") + "\n"
+	content += subtitleStyle.Render("Re-runs the pipeline whenever the input file changes") + "\n\n"
+
+	content += "Watching: " + dimStyle.Render(m.inputFile) + "\n"
+
+	curated := 0
+	if stats, ok := m.results["curate"]; ok {
+		curated = stats["shortlisted"]
+	}
+	content += fmt.Sprintf("Last run: %s, %d rows curated\n", formatAgo(m.lastRunAt), curated)
+
+	if m.errMsg != "" {
+		content += "\n" + errorStyle.Render(m.errMsg)
+	}
+
+	content += "\n" + dimStyle.Render("q to stop watching")
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center,
+		menuStyle.Render(content))
+}